@@ -3,8 +3,11 @@ package clicfg
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/neo4j/cli/common/clicfg/credentials"
 	"github.com/neo4j/cli/common/clicfg/fileutils"
@@ -24,7 +27,10 @@ const (
 	DefaultAuraBetaEnabled = false
 )
 
-var ValidOutputValues = [3]string{"default", "json", "table"}
+// Config keys that are followed by a name, such as columns.instance-list for the default columns of instance list
+var ValidConfigKeyPrefixes = []string{"columns."}
+
+var ValidOutputValues = [9]string{"default", "json", "table", "yaml", "csv", "tsv", "ndjson", "template=<template>", "jsonpath=<expression>"}
 
 type Config struct {
 	Version     string
@@ -70,7 +76,8 @@ func NewConfig(fs afero.Fs, version string) *Config {
 				MaxRetries: 60,
 				Interval:   20,
 			},
-			ValidConfigKeys: []string{"auth-url", "base-url", "default-tenant", "output", "beta-enabled"},
+			errWriter:       os.Stderr,
+			ValidConfigKeys: []string{"auth-url", "base-url", "default-tenant", "output", "beta-enabled", "audit-log-enabled", "audit-log-path"},
 		},
 		Credentials: credentials,
 	}
@@ -92,6 +99,8 @@ type AuraConfig struct {
 	viper           *viper.Viper
 	fs              afero.Fs
 	pollingOverride PollingConfig
+	errWriter       io.Writer
+	commandArgs     []string
 	ValidConfigKeys []string
 }
 
@@ -101,7 +110,9 @@ type PollingConfig struct {
 }
 
 func (config *AuraConfig) IsValidConfigKey(key string) bool {
-	return slices.Contains(config.ValidConfigKeys, key)
+	return slices.Contains(config.ValidConfigKeys, key) || slices.ContainsFunc(ValidConfigKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix) && len(key) > len(prefix)
+	})
 }
 
 func (config *AuraConfig) Get(key string) interface{} {
@@ -168,6 +179,17 @@ func (config *AuraConfig) BindOutput(flag *pflag.Flag) {
 	}
 }
 
+// The default columns of a command, such as instance-list, empty when the command uses its own columns
+func (config *AuraConfig) Columns(command string) []string {
+	columns := []string{}
+	for _, column := range strings.Split(config.viper.GetString(fmt.Sprintf("aura.columns.%s", command)), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
 func (config *AuraConfig) AuraBetaEnabled() bool {
 	return config.viper.GetBool("aura.beta-enabled")
 }
@@ -176,10 +198,59 @@ func (config *AuraConfig) DefaultTenant() string {
 	return config.viper.GetString("aura.default-tenant")
 }
 
+// Sets a config value for the rest of the process without writing it to the config file, such as the tenant used in the shell
+func (config *AuraConfig) Override(key string, value string) {
+	config.viper.Set(fmt.Sprintf("aura.%s", key), value)
+}
+
+// The audit log is enabled unless explicitly disabled
+func (config *AuraConfig) AuditLogEnabled() bool {
+	if !config.viper.IsSet("aura.audit-log-enabled") {
+		return true
+	}
+	return config.viper.GetBool("aura.audit-log-enabled")
+}
+
+func (config *AuraConfig) AuditLogPath() string {
+	path := config.viper.GetString("aura.audit-log-path")
+	if path == "" {
+		return filepath.Join(ConfigPrefix, "neo4j", "cli", "audit.jsonl")
+	}
+	return path
+}
+
+// Directory for data cached between commands, such as the instance configurations of tenants
+func (config *AuraConfig) CacheDir() string {
+	return filepath.Join(ConfigPrefix, "neo4j", "cli", "cache")
+}
+
 func (config *AuraConfig) Fs() afero.Fs {
 	return config.fs
 }
 
+// Where warnings that must not fail the command are written, such as a failure to write the audit log
+func (config *AuraConfig) ErrWriter() io.Writer {
+	return config.errWriter
+}
+
+// Sets where warnings are written, such as the error output of the shell or the batch running the command
+func (config *AuraConfig) SetErrWriter(w io.Writer) {
+	config.errWriter = w
+}
+
+// The arguments of the command being run, such as for the audit log, which are those of the process unless set
+func (config *AuraConfig) CommandArgs() []string {
+	if config.commandArgs == nil {
+		return os.Args[1:]
+	}
+	return config.commandArgs
+}
+
+// Sets the arguments of the command being run, such as a command of the shell or a step of a batch
+func (config *AuraConfig) SetCommandArgs(args []string) {
+	config.commandArgs = args
+}
+
 func (config *AuraConfig) PollingConfig() PollingConfig {
 	return config.pollingOverride
 }
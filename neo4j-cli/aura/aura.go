@@ -4,29 +4,55 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/apply"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/audit"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/batch"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/config"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/cost"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/credential"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/customermanagedkey"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/dashboard"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/dataapi"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/export"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/graph"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/plan"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/report"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/shell"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/tenant"
 )
 
 func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "aura",
-		Short:   "Allows you to programmatically provision and manage your Aura resources",
+		Use:   "aura",
+		Short: "Allows you to programmatically provision and manage your Aura resources",
+		Long: `Allows you to programmatically provision and manage your Aura resources.
+
+Wherever a tenant, instance, customer managed key, GraphQL Data API or authentication provider ID is expected, you can also use a unique prefix of the ID, or the name of the resource prefixed with name:, for example name:staging-db.`,
 		Version: cfg.Version,
 	}
 
+	cmd.AddCommand(apply.NewCmd(cfg))
+	cmd.AddCommand(audit.NewCmd(cfg))
+	cmd.AddCommand(batch.NewCmd(cfg, func() *cobra.Command { return NewCmd(cfg) }))
 	cmd.AddCommand(config.NewCmd(cfg))
+	cmd.AddCommand(cost.NewCmd(cfg))
 	cmd.AddCommand(credential.NewCmd(cfg))
 	cmd.AddCommand(customermanagedkey.NewCmd(cfg))
+	cmd.AddCommand(dashboard.NewCmd(cfg))
+	cmd.AddCommand(export.NewCmd(cfg))
+	cmd.AddCommand(graph.NewCmd(cfg))
 	cmd.AddCommand(instance.NewCmd(cfg))
+	cmd.AddCommand(plan.NewCmd(cfg))
+	cmd.AddCommand(report.NewCmd(cfg))
+	cmd.AddCommand(shell.NewCmd(cfg, func() *cobra.Command { return NewCmd(cfg) }))
 	cmd.AddCommand(tenant.NewCmd(cfg))
 	if cfg.Aura.AuraBetaEnabled() {
 		cmd.AddCommand(dataapi.NewCmd(cfg))
 	}
 
+	completion.Values(cmd)
+
 	return cmd
 }
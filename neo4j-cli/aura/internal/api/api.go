@@ -7,12 +7,20 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
 )
 
 const userAgent = "Neo4jCLI/%s"
 
+// Requests without a response within this time fail with ErrNoResponse
+const requestTimeout = 2 * time.Minute
+
+// Shared by every request, so that connections are reused across the requests of a process, such as the commands of the shell
+var client = &http.Client{Timeout: requestTimeout}
+
 type Grant struct {
 	AccessToken string `json:"access_token"`
 	ExpiresIn   int64  `json:"expires_in"`
@@ -22,10 +30,11 @@ type RequestConfig struct {
 	Method      string
 	PostBody    map[string]any
 	QueryParams map[string]string
+	// The name of the stored credential to authenticate with, the default credential if empty
+	Credential string
 }
 
 func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (responseBody []byte, statusCode int, err error) {
-	client := http.Client{}
 	var method = config.Method
 	if method == "" {
 		panic(fmt.Sprintf("method not set in requests %s", path))
@@ -47,7 +56,7 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 		panic(err)
 	}
 
-	credential, err := cfg.Credentials.Aura.GetDefault()
+	credential, err := getCredential(cfg, config.Credential)
 	if err != nil {
 		return responseBody, 0, err
 	}
@@ -59,7 +68,10 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 
 	res, err := client.Do(req)
 	if err != nil {
-		panic(err)
+		// The request may still have been processed, so it is recorded without a status
+		warnOnAuditError(cfg, writeAuditRecord(cfg, credential.Name, method, path, 0, nil))
+
+		return responseBody, 0, fmt.Errorf("%w for %s %s: %w", ErrNoResponse, method, path, err)
 	}
 
 	defer res.Body.Close()
@@ -71,12 +83,23 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 			panic(err)
 		}
 
+		warnOnAuditError(cfg, writeAuditRecord(cfg, credential.Name, method, path, res.StatusCode, responseBody))
+
 		return responseBody, res.StatusCode, nil
 	}
 
+	warnOnAuditError(cfg, writeAuditRecord(cfg, credential.Name, method, path, res.StatusCode, nil))
+
 	return responseBody, res.StatusCode, handleResponseError(res, credential, cfg)
 }
 
+func getCredential(cfg *clicfg.Config, name string) (*credentials.AuraCredential, error) {
+	if name == "" {
+		return cfg.Credentials.Aura.GetDefault()
+	}
+	return cfg.Credentials.Aura.Get(name)
+}
+
 func createBody(data map[string]any) io.Reader {
 	if data == nil {
 		return nil
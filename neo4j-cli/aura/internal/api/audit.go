@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+const redacted = "********"
+
+// Flags whose values must never be written to the audit log
+var secretFlags = []string{"--client-secret", "--instance-password"}
+
+// Path segments naming a collection or an action rather than a resource
+var nonResourceSegments = []string{"instances", "snapshots", "overwrite", "pause", "resume", "data-apis", "graphql", "auth-providers", "customer-managed-keys", "tenants", "metrics-integration"}
+
+var auditLogMutex sync.Mutex
+
+type AuditRecord struct {
+	Timestamp  string `json:"timestamp"`
+	Credential string `json:"credential"`
+	Command    string `json:"command"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Status     int    `json:"status"`
+	ResourceId string `json:"resource_id"`
+}
+
+// Appends a record of a mutating request to the audit log, GET requests are not recorded
+func writeAuditRecord(cfg *clicfg.Config, credentialName string, method string, path string, statusCode int, responseBody []byte) error {
+	if method == http.MethodGet || !cfg.Aura.AuditLogEnabled() {
+		return nil
+	}
+
+	record := AuditRecord{
+		Timestamp:  time.Now().UTC().Format(time.RFC3339),
+		Credential: credentialName,
+		Command:    strings.Join(RedactArgs(cfg.Aura.CommandArgs()), " "),
+		Method:     method,
+		Path:       path,
+		Status:     statusCode,
+		ResourceId: resourceId(path, responseBody),
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	auditLogMutex.Lock()
+	defer auditLogMutex.Unlock()
+
+	fs := cfg.Aura.Fs()
+	logPath := cfg.Aura.AuditLogPath()
+	if err := fs.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return fmt.Errorf("unable to create audit log directory: %w", err)
+	}
+
+	file, err := fs.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("unable to write audit log: %w", err)
+	}
+	return nil
+}
+
+// The request has already been made when it is recorded, so failing to record it must not lose its response
+func warnOnAuditError(cfg *clicfg.Config, err error) {
+	if err != nil {
+		fmt.Fprintf(cfg.Aura.ErrWriter(), "Warning: %s\n", err)
+	}
+}
+
+// Reads every record of the audit log, in the order they were written
+func ReadAuditLog(cfg *clicfg.Config) ([]AuditRecord, error) {
+	data := fileutils.ReadFileSafe(cfg.Aura.Fs(), cfg.Aura.AuditLogPath())
+
+	records := []AuditRecord{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var record AuditRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return nil, clierr.NewFatalError("invalid audit log entry %s: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+// Replaces the values of secret flags, both as "--flag value" and "--flag=value"
+func RedactArgs(args []string) []string {
+	result := make([]string, len(args))
+	redactNext := false
+	for i, arg := range args {
+		switch {
+		case redactNext:
+			result[i] = redacted
+			redactNext = false
+		case slices.Contains(secretFlags, arg):
+			result[i] = arg
+			redactNext = true
+		default:
+			result[i] = arg
+			for _, flag := range secretFlags {
+				if strings.HasPrefix(arg, flag+"=") {
+					result[i] = flag + "=" + redacted
+				}
+			}
+		}
+	}
+	return result
+}
+
+// The ID of the resource returned by the request, otherwise the last resource ID in the path
+func resourceId(path string, responseBody []byte) string {
+	if len(responseBody) > 0 {
+		var response SingleValueResponseData
+		if err := json.Unmarshal(responseBody, &response); err == nil {
+			for _, key := range []string{"id", "snapshot_id"} {
+				if id, ok := response.Data[key].(string); ok && id != "" {
+					return id
+				}
+			}
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != "" && !slices.Contains(nonResourceSegments, segments[i]) {
+			return segments[i]
+		}
+	}
+	return ""
+}
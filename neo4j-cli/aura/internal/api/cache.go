@@ -0,0 +1,53 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/neo4j/cli/common/clicfg"
+)
+
+type cachedValue[T any] struct {
+	FetchedAt int64 `json:"fetched_at"`
+	Value     T     `json:"value"`
+}
+
+// Reads a value from the cache when it was fetched within the TTL, otherwise fetches it and writes it to the cache.
+// Values are cached by name, base URL and credential, as the base URL and the credential change the resources that are fetched.
+func Cached[T any](cfg *clicfg.Config, name string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	fs := cfg.Aura.Fs()
+	path := CachePath(cfg, name)
+
+	if data, err := afero.ReadFile(fs, path); err == nil {
+		var cached cachedValue[T]
+		if err := json.Unmarshal(data, &cached); err == nil && time.Since(time.UnixMilli(cached.FetchedAt)) < ttl {
+			return cached.Value, nil
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	// The cache is only an optimisation, so failing to write it is not an error
+	if data, err := json.Marshal(cachedValue[T]{FetchedAt: time.Now().UnixMilli(), Value: value}); err == nil {
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err == nil {
+			afero.WriteFile(fs, path, data, 0600)
+		}
+	}
+
+	return value, nil
+}
+
+// The path of the cache file of a value, which depends on the base URL and the credential
+func CachePath(cfg *clicfg.Config, name string) string {
+	hash := fnv.New64a()
+	hash.Write([]byte(cfg.Aura.BaseUrl() + "\n" + cfg.Credentials.Aura.DefaultCredential))
+	return filepath.Join(cfg.Aura.CacheDir(), fmt.Sprintf("%s-%x.json", name, hash.Sum64()))
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+// Calls fn for every index from 0 to n-1, with at most concurrency calls running at once.
+// With a rate limit above zero, no more than rateLimit calls are started per second.
+// Returns once every call has returned.
+func RunConcurrently(n int, concurrency int, rateLimit float64, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mutex sync.Mutex
+		next  = time.Now()
+	)
+	throttle := func() {
+		if rateLimit <= 0 {
+			return
+		}
+		interval := time.Duration(float64(time.Second) / rateLimit)
+
+		mutex.Lock()
+		now := time.Now()
+		start := next
+		if start.Before(now) {
+			start = now
+		}
+		next = start.Add(interval)
+		mutex.Unlock()
+
+		time.Sleep(time.Until(start))
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				throttle()
+				fn(i)
+			}
+		}()
+	}
+
+	for i := range n {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
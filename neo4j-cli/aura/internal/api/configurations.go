@@ -0,0 +1,184 @@
+package api
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// How long the instance configurations of a tenant are cached for
+const instanceConfigurationsCacheTtl = time.Hour
+
+// Number of valid values suggested when a value is not available
+const suggestions = 3
+
+var memoryPattern = regexp.MustCompile(`^([0-9.]+)\s*(GB|MB)$`)
+
+// An instance type, cloud provider, region and memory size
+type InstanceConfiguration struct {
+	Type          string
+	CloudProvider string
+	Region        string
+	Memory        string
+}
+
+// Lists the instance configurations that can be provisioned in a tenant.
+// They are cached for an hour, as they seldom change.
+func GetInstanceConfigurations(cfg *clicfg.Config, tenantId string) ([]map[string]any, error) {
+	return Cached(cfg, fmt.Sprintf("instance-configurations-%s", tenantId), instanceConfigurationsCacheTtl, func() ([]map[string]any, error) {
+		tenant, err := GetTenant(cfg, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		configurations := []map[string]any{}
+		values, _ := tenant["instance_configurations"].([]any)
+		for _, value := range values {
+			if configuration, ok := value.(map[string]any); ok {
+				configurations = append(configurations, configuration)
+			}
+		}
+		return configurations, nil
+	})
+}
+
+// Checks that the configuration is offered to the tenant, suggesting the nearest valid values if it is not.
+// Empty values of the configuration are not checked. Tenants without instance configurations are not validated.
+func ValidateInstanceConfiguration(cfg *clicfg.Config, tenantId string, configuration InstanceConfiguration) error {
+	configurations, err := GetInstanceConfigurations(cfg, tenantId)
+	if err != nil {
+		return err
+	}
+	if len(configurations) == 0 {
+		return nil
+	}
+
+	matching := filterConfigurations(configurations, "type", configuration.Type)
+	if len(matching) == 0 {
+		return clierr.NewUsageError("instance type %s is not available in tenant %s, available types are %s",
+			configuration.Type, tenantId, strings.Join(distinctValues(configurations, "type"), ", "))
+	}
+
+	byCloudProvider := filterConfigurations(matching, "cloud_provider", configuration.CloudProvider)
+	if len(byCloudProvider) == 0 {
+		return clierr.NewUsageError("cloud provider %s is not available for %s instances in tenant %s, available cloud providers are %s",
+			configuration.CloudProvider, configuration.Type, tenantId, strings.Join(distinctValues(matching, "cloud_provider"), ", "))
+	}
+	matching = byCloudProvider
+
+	byRegion := filterConfigurations(matching, "region", configuration.Region)
+	if len(byRegion) == 0 {
+		return clierr.NewUsageError("region %s is not available for %s instances on %s in tenant %s, the nearest regions are %s",
+			configuration.Region, configuration.Type, configuration.CloudProvider, tenantId, strings.Join(nearestRegions(distinctValues(matching, "region"), configuration.Region), ", "))
+	}
+	matching = byRegion
+
+	byMemory := filterConfigurations(matching, "memory", configuration.Memory)
+	if len(byMemory) == 0 {
+		return clierr.NewUsageError("memory %s is not available for %s instances in %s in tenant %s, the nearest memory sizes are %s",
+			configuration.Memory, configuration.Type, configuration.Region, tenantId, strings.Join(nearestMemories(distinctValues(matching, "memory"), configuration.Memory), ", "))
+	}
+
+	return nil
+}
+
+// Parses memory such as 8GB or 512MB into GB
+func MemoryInGB(memory string) (float64, bool) {
+	matches := memoryPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(memory)))
+	if matches == nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	if matches[2] == "MB" {
+		return value / 1024, true
+	}
+	return value, true
+}
+
+func filterConfigurations(configurations []map[string]any, key string, value string) []map[string]any {
+	if value == "" {
+		return configurations
+	}
+	filtered := []map[string]any{}
+	for _, configuration := range configurations {
+		if configurationValue, _ := configuration[key].(string); strings.EqualFold(configurationValue, value) {
+			filtered = append(filtered, configuration)
+		}
+	}
+	return filtered
+}
+
+func distinctValues(configurations []map[string]any, key string) []string {
+	values := []string{}
+	for _, configuration := range configurations {
+		if value, _ := configuration[key].(string); value != "" && !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// The regions with the names closest to the region, such as europe-west1 for europe-west3
+func nearestRegions(regions []string, region string) []string {
+	slices.SortStableFunc(regions, func(a string, b string) int {
+		return editDistance(a, region) - editDistance(b, region)
+	})
+	return regions[:min(suggestions, len(regions))]
+}
+
+// The memory sizes closest to the memory, in ascending order
+func nearestMemories(memories []string, memory string) []string {
+	target, ok := MemoryInGB(memory)
+	if !ok {
+		return memories[:min(suggestions, len(memories))]
+	}
+	distance := func(m string) float64 {
+		size, ok := MemoryInGB(m)
+		if !ok {
+			return math.Inf(1)
+		}
+		return math.Abs(size - target)
+	}
+	slices.SortStableFunc(memories, func(a string, b string) int {
+		return cmp.Compare(distance(a), distance(b))
+	})
+	nearest := memories[:min(suggestions, len(memories))]
+	slices.SortFunc(nearest, func(a string, b string) int {
+		sizeA, _ := MemoryInGB(a)
+		sizeB, _ := MemoryInGB(b)
+		return cmp.Compare(sizeA, sizeB)
+	})
+	return nearest
+}
+
+// The Levenshtein distance between two strings
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
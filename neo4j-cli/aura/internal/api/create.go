@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/neo4j/cli/common/clicfg"
+)
+
+// Returned when a request was sent but no response was received, such as on a timeout
+var ErrNoResponse = errors.New("no response received")
+
+const createAttempts = 3
+
+// Finds an existing resource, returning nil if there is none
+type Lookup func() (map[string]any, error)
+
+// Creates a resource with a POST request, unless the lookup finds an existing one which is returned instead.
+// A POST without a response may still have created the resource, so it is only retried if the lookup still finds nothing.
+// The response body of an existing resource has the same shape as a created one.
+func CreateIfNotExists(cfg *clicfg.Config, path string, body map[string]any, lookup Lookup) (responseBody []byte, statusCode int, created bool, err error) {
+	for attempt := 1; ; attempt++ {
+		existing, err := lookup()
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if existing != nil {
+			responseBody, err := json.Marshal(SingleValueResponseData{Data: existing})
+			if err != nil {
+				return nil, 0, false, err
+			}
+			return responseBody, http.StatusOK, false, nil
+		}
+
+		responseBody, statusCode, err = MakeRequest(cfg, path, &RequestConfig{
+			Method:   http.MethodPost,
+			PostBody: body,
+		})
+		if !errors.Is(err, ErrNoResponse) || attempt == createAttempts {
+			return responseBody, statusCode, err == nil, err
+		}
+	}
+}
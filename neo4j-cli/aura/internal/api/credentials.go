@@ -0,0 +1,51 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Name of the field added to resources listed with every stored credential
+const CredentialField = "credential"
+
+// Lists the resources at the path with every stored credential concurrently, merging them in the order of the credentials.
+// Each resource gets a credential field with the name of the credential it was listed with.
+func ListWithAllCredentials(cfg *clicfg.Config, path string, queryParams map[string]string) ([]map[string]any, error) {
+	credentials := cfg.Credentials.Aura.List()
+	if len(credentials) == 0 {
+		return nil, clierr.NewUsageError("no credentials found, use the `credential add` subcommand to add credentials")
+	}
+
+	lists := make([][]map[string]any, len(credentials))
+	errs := make([]error, len(credentials))
+	RunConcurrently(len(credentials), len(credentials), 0, func(i int) {
+		resBody, _, err := MakeRequest(cfg, path, &RequestConfig{
+			Method:      http.MethodGet,
+			QueryParams: queryParams,
+			Credential:  credentials[i].Name,
+		})
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		lists[i] = []map[string]any{}
+		if len(resBody) > 0 {
+			lists[i] = ParseBody(resBody).AsArray()
+		}
+	})
+
+	merged := []map[string]any{}
+	for i, list := range lists {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("listing with credential %s: %w", credentials[i].Name, errs[i])
+		}
+		for _, resource := range list {
+			resource[CredentialField] = credentials[i].Name
+			merged = append(merged, resource)
+		}
+	}
+	return merged, nil
+}
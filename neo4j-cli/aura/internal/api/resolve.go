@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Prefix of a value that refers to a resource by its name rather than its ID
+const NamePrefix = "name:"
+
+var (
+	shortIdPattern  = regexp.MustCompile(`^[0-9a-f]{8}$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	idPrefixPattern = regexp.MustCompile(`^[0-9a-f-]+$`)
+)
+
+// Resolves an instance ID, an instance ID prefix or name:<instance name> to an instance ID
+func ResolveInstanceId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(value, "instance", shortIdPattern, func() ([]map[string]any, error) {
+		return ListInstances(cfg, "")
+	})
+}
+
+// Resolves a tenant ID, a tenant ID prefix or name:<tenant name> to a tenant ID
+func ResolveTenantId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(value, "tenant", uuidPattern, func() ([]map[string]any, error) {
+		return ListTenants(cfg)
+	})
+}
+
+// Resolves a customer managed key ID, a key ID prefix or name:<key name> to a customer managed key ID
+func ResolveCMKId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(value, "customer managed key", uuidPattern, func() ([]map[string]any, error) {
+		return ListCMKs(cfg, "")
+	})
+}
+
+// Resolves a GraphQL Data API ID, an ID prefix or name:<GraphQL Data API name> to the ID of a GraphQL Data API of the instance
+func ResolveGraphQLDataApiId(cfg *clicfg.Config, instanceId string, value string) (string, error) {
+	return resolveId(value, "GraphQL Data API", shortIdPattern, func() ([]map[string]any, error) {
+		return ListGraphQLDataApis(cfg, instanceId)
+	})
+}
+
+// Resolves an authentication provider ID, an ID prefix or name:<provider name> to the ID of an authentication provider of the GraphQL Data API
+func ResolveAuthProviderId(cfg *clicfg.Config, instanceId string, graphQLDataApiId string, value string) (string, error) {
+	return resolveId(value, "authentication provider", uuidPattern, func() ([]map[string]any, error) {
+		return ListAuthProviders(cfg, instanceId, graphQLDataApiId)
+	})
+}
+
+// Resolves a snapshot ID or a snapshot ID prefix to the ID of a snapshot of the instance. Prefixes are matched against the snapshots of the current day.
+func ResolveSnapshotId(cfg *clicfg.Config, instanceId string, value string) (string, error) {
+	return resolveId(value, "snapshot", uuidPattern, func() ([]map[string]any, error) {
+		snapshots, err := ListSnapshots(cfg, instanceId, "")
+		if err != nil {
+			return nil, err
+		}
+		// Snapshots have a snapshot_id and no name, so they are matched as resources named by their timestamp
+		resources := []map[string]any{}
+		for _, snapshot := range snapshots {
+			resources = append(resources, map[string]any{"id": snapshot["snapshot_id"], "name": snapshot["timestamp"]})
+		}
+		return resources, nil
+	})
+}
+
+// Full IDs, and values that cannot be an ID prefix, are returned as they are without listing the resources.
+// Otherwise the value must match exactly one resource by ID prefix or, with the name: prefix, by name.
+func resolveId(value string, kind string, idPattern *regexp.Regexp, list func() ([]map[string]any, error)) (string, error) {
+	name, byName := strings.CutPrefix(value, NamePrefix)
+	if !byName && (idPattern.MatchString(value) || !idPrefixPattern.MatchString(value)) {
+		return value, nil
+	}
+
+	resources, err := list()
+	if err != nil {
+		return "", err
+	}
+
+	matches := []map[string]any{}
+	for _, resource := range resources {
+		id, _ := resource["id"].(string)
+		if byName && resource["name"] == name || !byName && strings.HasPrefix(id, value) {
+			matches = append(matches, resource)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if byName {
+			return "", clierr.NewUsageError("no %s is named %s", kind, name)
+		}
+		return "", clierr.NewUsageError("no %s ID starts with %s", kind, value)
+	case 1:
+		id, _ := matches[0]["id"].(string)
+		return id, nil
+	default:
+		candidates := []string{}
+		for _, match := range matches {
+			candidates = append(candidates, fmt.Sprintf("\t%s (%s)", match["id"], match["name"]))
+		}
+		return "", clierr.NewUsageError("%s matches more than one %s, use the full ID:\n%s", value, kind, strings.Join(candidates, "\n"))
+	}
+}
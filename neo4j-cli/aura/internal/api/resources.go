@@ -0,0 +1,201 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+func ListTenants(cfg *clicfg.Config) ([]map[string]any, error) {
+	return getList(cfg, "/tenants", nil)
+}
+
+func GetTenant(cfg *clicfg.Config, tenantId string) (map[string]any, error) {
+	return getSingle(cfg, fmt.Sprintf("/tenants/%s", tenantId))
+}
+
+// Lists the instances of a tenant, or of every tenant if the tenant ID is empty
+func ListInstances(cfg *clicfg.Config, tenantId string) ([]map[string]any, error) {
+	queryParams := map[string]string{}
+	if tenantId != "" {
+		queryParams["tenantId"] = tenantId
+	}
+	return getList(cfg, "/instances", queryParams)
+}
+
+func GetInstance(cfg *clicfg.Config, instanceId string) (map[string]any, error) {
+	return getSingle(cfg, fmt.Sprintf("/instances/%s", instanceId))
+}
+
+// Number of instances whose details are fetched at once by GetInstances
+const getInstancesConcurrency = 4
+
+// Gets the details of listed instances concurrently, such as for their status which lists do not have.
+// The details are in the order of the instances, and the first error is returned if a request fails.
+func GetInstances(cfg *clicfg.Config, instances []map[string]any) ([]map[string]any, error) {
+	details := make([]map[string]any, len(instances))
+	errs := make([]error, len(instances))
+	RunConcurrently(len(instances), getInstancesConcurrency, 0, func(i int) {
+		id, _ := instances[i]["id"].(string)
+		details[i], errs[i] = GetInstance(cfg, id)
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
+
+// Lists the snapshots of an instance for a given date (YYYY-MM-DD), or for the current day if the date is empty
+func ListSnapshots(cfg *clicfg.Config, instanceId string, date string) ([]map[string]any, error) {
+	queryParams := map[string]string{}
+	if date != "" {
+		queryParams["date"] = date
+	}
+	return getList(cfg, fmt.Sprintf("/instances/%s/snapshots", instanceId), queryParams)
+}
+
+// Lists the customer managed keys of a tenant, or of every tenant if the tenant ID is empty
+func ListCMKs(cfg *clicfg.Config, tenantId string) ([]map[string]any, error) {
+	queryParams := map[string]string{}
+	if tenantId != "" {
+		queryParams["tenantId"] = tenantId
+	}
+	return getList(cfg, "/customer-managed-keys", queryParams)
+}
+
+func GetCMK(cfg *clicfg.Config, cmkId string) (map[string]any, error) {
+	return getSingle(cfg, fmt.Sprintf("/customer-managed-keys/%s", cmkId))
+}
+
+func ListGraphQLDataApis(cfg *clicfg.Config, instanceId string) ([]map[string]any, error) {
+	return getList(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId), nil)
+}
+
+func GetGraphQLDataApi(cfg *clicfg.Config, instanceId string, graphQLDataApiId string) (map[string]any, error) {
+	return getSingle(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, graphQLDataApiId))
+}
+
+func ListAuthProviders(cfg *clicfg.Config, instanceId string, graphQLDataApiId string) ([]map[string]any, error) {
+	return getList(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, graphQLDataApiId), nil)
+}
+
+func GetAuthProvider(cfg *clicfg.Config, instanceId string, graphQLDataApiId string, authProviderId string) (map[string]any, error) {
+	return getSingle(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, graphQLDataApiId, authProviderId))
+}
+
+// Finds the instance of a tenant with the given name, returning nil if there is none
+func FindInstanceByName(cfg *clicfg.Config, tenantId string, name string) (map[string]any, error) {
+	instances, err := ListInstances(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	return getByName(instances, "instance", name, func(id string) (map[string]any, error) {
+		return GetInstance(cfg, id)
+	})
+}
+
+// Finds the customer managed key of a tenant with the given name, returning nil if there is none
+func FindCMKByName(cfg *clicfg.Config, tenantId string, name string) (map[string]any, error) {
+	cmks, err := ListCMKs(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	return getByName(cmks, "customer managed key", name, func(id string) (map[string]any, error) {
+		return GetCMK(cfg, id)
+	})
+}
+
+// Finds the GraphQL Data API of an instance with the given name, returning nil if there is none
+func FindGraphQLDataApiByName(cfg *clicfg.Config, instanceId string, name string) (map[string]any, error) {
+	graphQLDataApis, err := ListGraphQLDataApis(cfg, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	return getByName(graphQLDataApis, "GraphQL Data API", name, func(id string) (map[string]any, error) {
+		return GetGraphQLDataApi(cfg, instanceId, id)
+	})
+}
+
+// Finds the authentication provider of a GraphQL Data API with the given name, returning nil if there is none
+func FindAuthProviderByName(cfg *clicfg.Config, instanceId string, graphQLDataApiId string, name string) (map[string]any, error) {
+	authProviders, err := ListAuthProviders(cfg, instanceId, graphQLDataApiId)
+	if err != nil {
+		return nil, err
+	}
+	return getByName(authProviders, "authentication provider", name, func(id string) (map[string]any, error) {
+		return GetAuthProvider(cfg, instanceId, graphQLDataApiId, id)
+	})
+}
+
+// Finds the only resource with the given name, returning nil if there is none and failing if the name is ambiguous
+func FindByName(resources []map[string]any, kind string, name string) (map[string]any, error) {
+	found := []map[string]any{}
+	ids := []string{}
+	for _, resource := range resources {
+		if StringValue(resource, "name") == name {
+			found = append(found, resource)
+			ids = append(ids, StringValue(resource, "id"))
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return found[0], nil
+	default:
+		return nil, clierr.NewUsageError("more than one %s is named %s, found %s", kind, name, strings.Join(ids, ", "))
+	}
+}
+
+// Gets the details of the only resource with the given name, failing if the name is ambiguous
+func getByName(resources []map[string]any, kind string, name string, get func(id string) (map[string]any, error)) (map[string]any, error) {
+	resource, err := FindByName(resources, kind, name)
+	if resource == nil || err != nil {
+		return nil, err
+	}
+	return get(StringValue(resource, "id"))
+}
+
+// The string value of a field of a resource, empty when it is missing or not a string
+func StringValue(resource map[string]any, key string) string {
+	if value, ok := resource[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func getList(cfg *clicfg.Config, path string, queryParams map[string]string) ([]map[string]any, error) {
+	resBody, _, err := MakeRequest(cfg, path, &RequestConfig{
+		Method:      http.MethodGet,
+		QueryParams: queryParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resBody) == 0 {
+		return []map[string]any{}, nil
+	}
+
+	return ParseBody(resBody).AsArray(), nil
+}
+
+func getSingle(cfg *clicfg.Config, path string) (map[string]any, error) {
+	resBody, _, err := MakeRequest(cfg, path, &RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resBody) == 0 {
+		return nil, clierr.NewUpstreamError("empty response body requesting %s", path)
+	}
+
+	return ParseBody(resBody).GetSingleOrError()
+}
@@ -323,7 +323,9 @@ func formatAuthorizationError(resBody []byte, statusCode int, credential *creden
 		messages = append(messages, e.Message)
 	}
 
+	tokenMutex.Lock()
 	_, err = cfg.Credentials.Aura.ClearAccessToken(credential)
+	tokenMutex.Unlock()
 	if err != nil {
 		messages = append(messages, "Request failed authorization - attempted to clear the access token but encountered an error, please report an issue in https://github.com/neo4j/cli")
 	} else {
@@ -7,13 +7,20 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clicfg/credentials"
 	"github.com/neo4j/cli/common/clierr"
 )
 
+// Serialises token refreshes, so concurrent requests share a single new token
+var tokenMutex sync.Mutex
+
 func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (string, error) {
+	tokenMutex.Lock()
+	defer tokenMutex.Unlock()
+
 	if credential.HasValidAccessToken() {
 		return credential.AccessToken, nil
 	}
@@ -37,8 +44,6 @@ func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (strin
 	}
 	req.SetBasicAuth(credential.ClientId, credential.ClientSecret)
 
-	client := http.Client{}
-
 	res, err := client.Do(req)
 	if err != nil {
 		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
@@ -0,0 +1,22 @@
+package commandline
+
+import "slices"
+
+// Commands that take over the terminal or run other commands, so cannot be run from the shell or a batch
+var unavailableCommands = []string{"batch", "shell", "dashboard"}
+
+// Removes the neo4j-cli aura prefix, which commands copied from scripts or the documentation can keep
+func TrimPrefix(args []string) []string {
+	if len(args) > 0 && args[0] == "neo4j-cli" {
+		args = args[1:]
+	}
+	if len(args) > 0 && args[0] == "aura" {
+		args = args[1:]
+	}
+	return args
+}
+
+// Whether the command cannot be run from the shell or a batch, such as batch, which would read the input of the shell
+func IsUnavailable(command string) bool {
+	return slices.Contains(unavailableCommands, command)
+}
@@ -0,0 +1,29 @@
+package completion
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// How long completions are cached for, so that completing the words of a command lists the resources once
+const cacheTtl = time.Minute
+
+// Lists the completions, or reads them from the cache when they were listed within the last minute
+func (l *listing) cached(cfg *clicfg.Config) ([]string, error) {
+	return api.Cached(cfg, fmt.Sprintf("completions-%s", l.key), cacheTtl, func() ([]string, error) {
+		values, err := l.list()
+		if err != nil {
+			return nil, err
+		}
+		completions := []string{}
+		for _, value := range values {
+			if id, ok := value[l.idField].(string); ok {
+				completions = append(completions, fmt.Sprintf("%s\t%v", id, value[l.descriptionField]))
+			}
+		}
+		return completions, nil
+	})
+}
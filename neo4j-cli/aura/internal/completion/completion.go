@@ -0,0 +1,181 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// A kind of resource whose IDs are completed
+type Resource string
+
+const (
+	Tenant             Resource = "tenant"
+	Instance           Resource = "instance"
+	Snapshot           Resource = "snapshot"
+	CustomerManagedKey Resource = "customer-managed-key"
+	GraphQLDataApi     Resource = "graphql-data-api"
+	AuthProvider       Resource = "auth-provider"
+	// Credentials are completed by name rather than ID
+	Credential Resource = "credential"
+)
+
+// Annotation of commands whose argument is the ID of a resource, with the resource as value,
+// so that the shell can use the resource in use when the argument is left out
+const ArgAnnotation = "aura-id-argument"
+
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// Completes the argument of a command with the IDs of a resource
+func Arg(cmd *cobra.Command, cfg *clicfg.Config, resource Resource) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[ArgAnnotation] = string(resource)
+
+	complete := ids(cfg, resource)
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// Completes a flag of a command with the IDs of a resource
+func Flag(cmd *cobra.Command, cfg *clicfg.Config, name string, resource Resource) {
+	if err := cmd.RegisterFlagCompletionFunc(name, ids(cfg, resource)); err != nil {
+		panic(err)
+	}
+}
+
+// Completes the flags with a fixed set of values, such as --memory, of a command and its subcommands
+func Values(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		value, ok := flag.Value.(interface{ Values() []string })
+		if _, registered := cmd.GetFlagCompletionFunc(flag.Name); !ok || registered {
+			return
+		}
+		if err := cmd.RegisterFlagCompletionFunc(flag.Name, cobra.FixedCompletions(value.Values(), cobra.ShellCompDirectiveNoFileComp)); err != nil {
+			panic(err)
+		}
+	})
+	for _, subcommand := range cmd.Commands() {
+		Values(subcommand)
+	}
+}
+
+// Lists the IDs starting with the value being completed, described by the names of the resources
+func ids(cfg *clicfg.Config, resource Resource) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var (
+			completions []string
+			err         error
+		)
+		if resource == Credential {
+			completions = credentials(cfg)
+		} else {
+			l := source(cfg, resource, cmd, args)
+			if l == nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			completions, err = l.cached(cfg)
+		}
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		matching := []string{}
+		for _, completion := range completions {
+			if strings.HasPrefix(completion, toComplete) {
+				matching = append(matching, completion)
+			}
+		}
+		return matching, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// How a resource is listed for completion
+type listing struct {
+	// Identifies the listing in the cache, with the IDs of the resources it belongs to
+	key              string
+	list             func() ([]map[string]any, error)
+	idField          string
+	descriptionField string
+}
+
+// How a resource is listed, nil when it cannot be listed, such as snapshots when no instance is given
+func source(cfg *clicfg.Config, resource Resource, cmd *cobra.Command, args []string) *listing {
+	switch resource {
+	case Tenant:
+		return &listing{"tenants", func() ([]map[string]any, error) { return api.ListTenants(cfg) }, "id", "name"}
+	case Instance:
+		return &listing{"instances", func() ([]map[string]any, error) { return api.ListInstances(cfg, "") }, "id", "name"}
+	case CustomerManagedKey:
+		return &listing{"customer-managed-keys", func() ([]map[string]any, error) { return api.ListCMKs(cfg, "") }, "id", "name"}
+	}
+
+	instanceId := instanceOf(cfg, cmd, args)
+	if instanceId == "" {
+		return nil
+	}
+	switch resource {
+	case Snapshot:
+		return &listing{"snapshots-" + instanceId, func() ([]map[string]any, error) {
+			return api.ListSnapshots(cfg, instanceId, "")
+		}, "snapshot_id", "timestamp"}
+	case GraphQLDataApi:
+		return &listing{"graphql-data-apis-" + instanceId, func() ([]map[string]any, error) {
+			return api.ListGraphQLDataApis(cfg, instanceId)
+		}, "id", "name"}
+	case AuthProvider:
+		value, _ := cmd.Flags().GetString("data-api-id")
+		if value == "" {
+			return nil
+		}
+		dataApiId, err := api.ResolveGraphQLDataApiId(cfg, instanceId, value)
+		if err != nil {
+			return nil
+		}
+		return &listing{fmt.Sprintf("auth-providers-%s-%s", instanceId, dataApiId), func() ([]map[string]any, error) {
+			return api.ListAuthProviders(cfg, instanceId, dataApiId)
+		}, "id", "name"}
+	}
+	return nil
+}
+
+// The instance that snapshots, GraphQL Data APIs and authentication providers are listed from.
+// It is given with --instance-id, or for instance overwrite with --source-instance-id or else the instance argument.
+func instanceOf(cfg *clicfg.Config, cmd *cobra.Command, args []string) string {
+	value := ""
+	for _, name := range []string{"instance-id", "source-instance-id"} {
+		if value == "" {
+			value, _ = cmd.Flags().GetString(name)
+		}
+	}
+	if value == "" && len(args) > 0 && cmd.Annotations[ArgAnnotation] == string(Instance) {
+		value = args[0]
+	}
+	if value == "" {
+		return ""
+	}
+	instanceId, err := api.ResolveInstanceId(cfg, value)
+	if err != nil {
+		return ""
+	}
+	return instanceId
+}
+
+// The names of the stored credentials, described by their client IDs
+func credentials(cfg *clicfg.Config) []string {
+	completions := []string{}
+	for _, credential := range cfg.Credentials.Aura.List() {
+		completions = append(completions, fmt.Sprintf("%s\t%s", credential.Name, credential.ClientId))
+	}
+	return completions
+}
@@ -0,0 +1,200 @@
+package cost
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Average number of hours in a month, used to turn hourly prices into monthly costs
+const HoursPerMonth = 730
+
+const defaultCurrency = "USD"
+
+// Fields to print an estimate with
+var EstimateFields = []string{"type", "cloud_provider", "region", "memory", "hourly_cost", "monthly_cost", "currency"}
+
+// The price of an instance configuration. Prices without a cloud provider or region apply to all of them.
+type Price struct {
+	Type          string  `yaml:"type" json:"type"`
+	CloudProvider string  `yaml:"cloud_provider,omitempty" json:"cloud_provider,omitempty"`
+	Region        string  `yaml:"region,omitempty" json:"region,omitempty"`
+	Memory        string  `yaml:"memory" json:"memory"`
+	PricePerHour  float64 `yaml:"price_per_hour" json:"price_per_hour"`
+}
+
+// A user supplied price file, in YAML or JSON
+type PriceFile struct {
+	Currency string  `yaml:"currency" json:"currency"`
+	Prices   []Price `yaml:"prices" json:"prices"`
+}
+
+// Prices instance configurations of a tenant
+type Pricing struct {
+	currency string
+	// Prices read from the instance configurations of the tenant, which take precedence over the price file
+	configurationPrices []Price
+	filePrices          []Price
+}
+
+// Reads the prices from the instance configurations of the tenant when they have a price_per_hour,
+// otherwise from the price file. Fails if neither has any price.
+func NewPricing(cfg *clicfg.Config, tenantId string, priceFile string) (*Pricing, error) {
+	configurations, err := api.GetInstanceConfigurations(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	pricing := &Pricing{currency: defaultCurrency}
+	for _, configuration := range configurations {
+		pricePerHour, ok := number(configuration["price_per_hour"])
+		if !ok {
+			continue
+		}
+		pricing.configurationPrices = append(pricing.configurationPrices, Price{
+			Type:          api.StringValue(configuration, "type"),
+			CloudProvider: api.StringValue(configuration, "cloud_provider"),
+			Region:        api.StringValue(configuration, "region"),
+			Memory:        api.StringValue(configuration, "memory"),
+			PricePerHour:  pricePerHour,
+		})
+		if currency := api.StringValue(configuration, "currency"); currency != "" {
+			pricing.currency = currency
+		}
+	}
+
+	if priceFile != "" {
+		file, err := LoadPriceFile(cfg.Aura.Fs(), priceFile)
+		if err != nil {
+			return nil, err
+		}
+		pricing.filePrices = file.Prices
+		if len(pricing.configurationPrices) == 0 && file.Currency != "" {
+			pricing.currency = file.Currency
+		}
+	}
+
+	if len(pricing.configurationPrices) == 0 && len(pricing.filePrices) == 0 {
+		return nil, clierr.NewUsageError("the instance configurations of tenant %s have no prices, use --price-file to provide a price file", tenantId)
+	}
+
+	return pricing, nil
+}
+
+func LoadPriceFile(fs afero.Fs, path string) (*PriceFile, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, clierr.NewUsageError("cannot read price file %s: %w", path, err)
+	}
+	var file PriceFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, clierr.NewUsageError("invalid price file %s: %w", path, err)
+	}
+	for _, price := range file.Prices {
+		if price.Type == "" || price.Memory == "" {
+			return nil, clierr.NewUsageError("invalid price file %s: every price must have a type and a memory", path)
+		}
+	}
+	return &file, nil
+}
+
+func (pricing *Pricing) Currency() string {
+	return pricing.currency
+}
+
+// The hourly price of a configuration, false if it has no price
+func (pricing *Pricing) HourlyPrice(configuration api.InstanceConfiguration) (float64, bool) {
+	if price, ok := lookup(pricing.configurationPrices, configuration); ok {
+		return price, true
+	}
+	return lookup(pricing.filePrices, configuration)
+}
+
+// The hourly and monthly cost of a configuration, nil if it has no price
+func (pricing *Pricing) Estimate(configuration api.InstanceConfiguration) map[string]any {
+	estimate := map[string]any{
+		"type":           configuration.Type,
+		"cloud_provider": configuration.CloudProvider,
+		"region":         configuration.Region,
+		"memory":         configuration.Memory,
+		"currency":       pricing.currency,
+		"hourly_cost":    nil,
+		"monthly_cost":   nil,
+	}
+	if hourly, ok := pricing.HourlyPrice(configuration); ok {
+		estimate["hourly_cost"] = Round(hourly)
+		estimate["monthly_cost"] = Round(hourly * HoursPerMonth)
+	}
+	return estimate
+}
+
+// Estimates the cost of a configuration, failing if it has no price
+func (pricing *Pricing) EstimateOrError(configuration api.InstanceConfiguration) (map[string]any, error) {
+	estimate := pricing.Estimate(configuration)
+	if estimate["hourly_cost"] == nil {
+		return nil, clierr.NewUsageError("no price found for a %s instance with %s memory in %s %s, add one to the price file", configuration.Type, configuration.Memory, configuration.CloudProvider, configuration.Region)
+	}
+	return estimate, nil
+}
+
+// The configuration of an instance, from its details
+func InstanceConfiguration(instance map[string]any) api.InstanceConfiguration {
+	return api.InstanceConfiguration{
+		Type:          api.StringValue(instance, "type"),
+		CloudProvider: api.StringValue(instance, "cloud_provider"),
+		Region:        api.StringValue(instance, "region"),
+		Memory:        api.StringValue(instance, "memory"),
+	}
+}
+
+// The most specific matching price, the first one if several are as specific
+func lookup(prices []Price, configuration api.InstanceConfiguration) (float64, bool) {
+	best := -1
+	var bestPrice float64
+	for _, price := range prices {
+		if !strings.EqualFold(price.Type, configuration.Type) || !strings.EqualFold(price.Memory, configuration.Memory) {
+			continue
+		}
+		specificity := 0
+		if price.CloudProvider != "" {
+			if !strings.EqualFold(price.CloudProvider, configuration.CloudProvider) {
+				continue
+			}
+			specificity++
+		}
+		if price.Region != "" {
+			if !strings.EqualFold(price.Region, configuration.Region) {
+				continue
+			}
+			specificity++
+		}
+		if specificity > best {
+			best = specificity
+			bestPrice = price.PricePerHour
+		}
+	}
+	return bestPrice, best >= 0
+}
+
+// Rounds a cost to cents
+func Round(cost float64) float64 {
+	return math.Round(cost*100) / 100
+}
+
+func number(value any) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case string:
+		parsed, err := strconv.ParseFloat(value, 64)
+		return parsed, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,290 @@
+package declarative
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+// Applies the actions of the plan in order, stopping at the first that fails
+func (plan *Plan) Apply(cmd *cobra.Command, cfg *clicfg.Config) error {
+	for _, action := range plan.Actions {
+		var err error
+		switch action.Kind {
+		case KindCustomerManagedKey:
+			err = plan.applyCMK(cmd, cfg, action)
+		case KindInstance:
+			err = plan.applyInstance(cmd, cfg, action)
+		case KindGraphQLDataApi:
+			err = plan.applyGraphQLDataApi(cmd, cfg, action)
+		case KindAuthProvider:
+			err = plan.applyAuthProvider(cmd, cfg, action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", action.Action, action.Kind, action.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (plan *Plan) applyCMK(cmd *cobra.Command, cfg *clicfg.Config, action *Action) error {
+	if action.Action == ActionDelete {
+		cmd.Printf("Deleting %s %s...\n", action.Kind, action.Name)
+		_, _, err := api.MakeRequest(cfg, fmt.Sprintf("/customer-managed-keys/%s", action.id), &api.RequestConfig{Method: http.MethodDelete})
+		return err
+	}
+
+	cmd.Printf("Creating %s %s...\n", action.Kind, action.Name)
+	cmk := action.cmk
+	resBody, _, err := api.MakeRequest(cfg, "/customer-managed-keys", &api.RequestConfig{
+		Method: http.MethodPost,
+		PostBody: map[string]any{
+			"name":           cmk.Name,
+			"tenant_id":      cmk.TenantId,
+			"instance_type":  cmk.Type,
+			"cloud_provider": cmk.CloudProvider,
+			"region":         cmk.Region,
+			"key_id":         cmk.KeyId,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"}); err != nil {
+		return err
+	}
+
+	var response api.CreateCMKResponse
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return err
+	}
+	plan.cmkIds[cmk.TenantId+"/"+cmk.Name] = response.Data.Id
+
+	// Instances can only be created with a key once it is ready
+	if plan.isCMKReferenced(cmk) {
+		cmd.Println("Waiting for customer managed key to be ready...")
+		if _, err := api.PollCMK(cfg, response.Data.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (plan *Plan) applyInstance(cmd *cobra.Command, cfg *clicfg.Config, action *Action) error {
+	switch action.Action {
+	case ActionDelete:
+		cmd.Printf("Deleting %s %s...\n", action.Kind, action.Name)
+		_, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", action.id), &api.RequestConfig{Method: http.MethodDelete})
+		return err
+	case ActionUpdate:
+		cmd.Printf("Updating %s %s...\n", action.Kind, action.Name)
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", action.id), &api.RequestConfig{
+			Method:   http.MethodPatch,
+			PostBody: action.body,
+		})
+		if err != nil {
+			return err
+		}
+		return output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+	}
+
+	cmd.Printf("Creating %s %s...\n", action.Kind, action.Name)
+	instance := action.instance
+	body := map[string]any{
+		"name":           instance.Name,
+		"tenant_id":      instance.TenantId,
+		"type":           instance.Type,
+		"version":        instance.Version,
+		"cloud_provider": instance.CloudProvider,
+		"region":         instance.Region,
+		"memory":         instance.Memory,
+	}
+	if instance.Version == "" {
+		body["version"] = "5"
+	}
+	if instance.Type == "free-db" {
+		body["memory"] = "1GB"
+		body["region"] = "europe-west1"
+		body["cloud_provider"] = "gcp"
+		body["version"] = "5"
+	}
+	if instance.CustomerManagedKey != "" {
+		body["customer_managed_key_id"] = plan.cmkIds[instance.TenantId+"/"+instance.CustomerManagedKey]
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+		Method:   http.MethodPost,
+		PostBody: body,
+	})
+	if err != nil {
+		return err
+	}
+	if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"}); err != nil {
+		return err
+	}
+
+	var response api.CreateInstanceResponse
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return err
+	}
+	plan.instanceIds[instance.Name] = response.Data.Id
+
+	// GraphQL Data APIs can only be created once the instance is running
+	if plan.isInstanceReferenced(instance) {
+		cmd.Println("Waiting for instance to be ready...")
+		if _, err := api.PollInstance(cfg, response.Data.Id, api.InstanceStatusCreating); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (plan *Plan) applyGraphQLDataApi(cmd *cobra.Command, cfg *clicfg.Config, action *Action) error {
+	switch action.Action {
+	case ActionDelete:
+		cmd.Printf("Deleting %s %s...\n", action.Kind, action.Name)
+		_, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql/%s", action.instanceId, action.id), &api.RequestConfig{Method: http.MethodDelete})
+		return err
+	case ActionUpdate:
+		cmd.Printf("Updating %s %s...\n", action.Kind, action.Name)
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql/%s", action.instanceId, action.id), &api.RequestConfig{
+			Method:   http.MethodPatch,
+			PostBody: action.body,
+		})
+		if err != nil {
+			return err
+		}
+		if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+			return err
+		}
+
+		// Authentication providers can only be changed once the update has finished
+		if plan.hasAuthProviderActions(action.id) {
+			cmd.Println("Waiting for GraphQL Data API to be updated...")
+			if _, err := api.PollGraphQLDataApi(cfg, action.instanceId, action.id, api.GraphQLDataApiStatusUpdating); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cmd.Printf("Creating %s %s...\n", action.Kind, action.Name)
+	graphQLDataApi := action.graphQLDataApi
+	authProviders := []map[string]any{}
+	for _, authProvider := range graphQLDataApi.AuthProviders {
+		authProviders = append(authProviders, authProviderBody(authProvider))
+	}
+	if len(authProviders) == 0 {
+		authProviders = append(authProviders, map[string]any{"type": api.GraphQLDataApiAuthProviderTypeApiKey, "name": "default", "enabled": true})
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql", plan.instanceIds[graphQLDataApi.Instance]), &api.RequestConfig{
+		Method: http.MethodPost,
+		PostBody: map[string]any{
+			"name":             graphQLDataApi.Name,
+			"type_definitions": base64.StdEncoding.EncodeToString([]byte(graphQLDataApi.typeDefinitions)),
+			"aura_instance": map[string]string{
+				"username": graphQLDataApi.InstanceUsername,
+				"password": graphQLDataApi.InstancePassword,
+			},
+			"security": map[string]any{
+				"authentication_providers": authProviders,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.Println("###############################")
+	cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
+	cmd.Println("###############################")
+	if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url", "authentication_providers"}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (plan *Plan) applyAuthProvider(cmd *cobra.Command, cfg *clicfg.Config, action *Action) error {
+	path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", action.instanceId, action.graphQLDataApiId)
+
+	if action.Action == ActionDelete || action.Action == ActionReplace {
+		cmd.Printf("Deleting %s %s...\n", action.Kind, action.Name)
+		if _, _, err := api.MakeRequest(cfg, fmt.Sprintf("%s/%s", path, action.id), &api.RequestConfig{Method: http.MethodDelete}); err != nil {
+			return err
+		}
+	}
+	if action.Action == ActionDelete {
+		return nil
+	}
+
+	cmd.Printf("Creating %s %s...\n", action.Kind, action.Name)
+	resBody, _, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+		Method:   http.MethodPost,
+		PostBody: authProviderBody(*action.authProvider),
+	})
+	if err != nil {
+		return err
+	}
+
+	if action.authProvider.Type == api.GraphQLDataApiAuthProviderTypeApiKey {
+		cmd.Println("###############################")
+		cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
+		cmd.Println("###############################")
+	}
+	if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "key", "url"}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func authProviderBody(authProvider AuthProviderSpec) map[string]any {
+	body := map[string]any{
+		"type":    authProvider.Type,
+		"name":    authProvider.Name,
+		"enabled": authProvider.Enabled,
+	}
+	if authProvider.Url != "" {
+		body["url"] = authProvider.Url
+	}
+	return body
+}
+
+func (plan *Plan) isCMKReferenced(cmk *CustomerManagedKeySpec) bool {
+	for _, action := range plan.Actions {
+		if action.Kind == KindInstance && action.Action == ActionCreate && action.instance.CustomerManagedKey == cmk.Name && action.instance.TenantId == cmk.TenantId {
+			return true
+		}
+	}
+	return false
+}
+
+func (plan *Plan) isInstanceReferenced(instance *InstanceSpec) bool {
+	for _, action := range plan.Actions {
+		if action.Kind == KindGraphQLDataApi && action.Action == ActionCreate && action.graphQLDataApi.Instance == instance.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (plan *Plan) hasAuthProviderActions(graphQLDataApiId string) bool {
+	for _, action := range plan.Actions {
+		if action.Kind == KindAuthProvider && action.graphQLDataApiId == graphQLDataApiId {
+			return true
+		}
+	}
+	return false
+}
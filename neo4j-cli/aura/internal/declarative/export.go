@@ -0,0 +1,258 @@
+package declarative
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const (
+	ExportFormatYaml = "yaml"
+	ExportFormatJson = "json"
+)
+
+var ValidExportFormats = []string{ExportFormatYaml, ExportFormatJson}
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// A file written by an export
+type ExportedFile struct {
+	Kind string
+	Name string
+	File string
+}
+
+type exporter struct {
+	fs     afero.Fs
+	dir    string
+	format string
+	files  []ExportedFile
+	// File names already used, by directory
+	used map[string]map[string]bool
+}
+
+// Writes the live resources of a tenant below a directory, one spec file per resource:
+//
+//	customer-managed-keys/<name>
+//	instances/<name>
+//	instances/<name>/snapshots/<snapshot id>
+//	instances/<name>/graphql-data-apis/<name>, with the type definitions in a .graphql file next to it
+//
+// Secrets, such as instance passwords and API keys, are never written.
+// GraphQL Data APIs are only exported when the beta is enabled.
+func Export(cfg *clicfg.Config, tenantId string, dir string, format string) ([]ExportedFile, error) {
+	e := &exporter{
+		fs:     cfg.Aura.Fs(),
+		dir:    dir,
+		format: format,
+		files:  []ExportedFile{},
+		used:   map[string]map[string]bool{},
+	}
+
+	liveCMKs, err := api.ListCMKs(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	cmkNames := map[string]string{}
+	for _, live := range liveCMKs {
+		id := api.StringValue(live, "id")
+		details, err := api.GetCMK(cfg, id)
+		if err != nil {
+			return nil, err
+		}
+		cmk := &CustomerManagedKeySpec{
+			Kind:          KindCustomerManagedKey,
+			Name:          api.StringValue(details, "name"),
+			TenantId:      tenantId,
+			Type:          api.StringValue(details, "type"),
+			CloudProvider: api.StringValue(details, "cloud_provider"),
+			Region:        api.StringValue(details, "region"),
+			KeyId:         api.StringValue(details, "key_id"),
+		}
+		cmkNames[id] = cmk.Name
+		if _, err := e.write("customer-managed-keys", cmk.Name, id, cmk.Kind, cmk); err != nil {
+			return nil, err
+		}
+	}
+
+	liveInstances, err := api.ListInstances(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	for _, live := range liveInstances {
+		id := api.StringValue(live, "id")
+		details, err := api.GetInstance(cfg, id)
+		if err != nil {
+			return nil, err
+		}
+		instance := &InstanceSpec{
+			Kind:          KindInstance,
+			Name:          api.StringValue(details, "name"),
+			TenantId:      tenantId,
+			Type:          api.StringValue(details, "type"),
+			CloudProvider: api.StringValue(details, "cloud_provider"),
+			Region:        api.StringValue(details, "region"),
+			Memory:        api.StringValue(details, "memory"),
+		}
+		if cmkId := api.StringValue(details, "customer_managed_key_id"); cmkId != "" {
+			instance.CustomerManagedKey = cmkId
+			if name, ok := cmkNames[cmkId]; ok {
+				instance.CustomerManagedKey = name
+			}
+		}
+		baseName, err := e.write("instances", instance.Name, id, instance.Kind, instance)
+		if err != nil {
+			return nil, err
+		}
+		instanceDir := filepath.Join("instances", baseName)
+
+		snapshots, err := api.ListSnapshots(cfg, id, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, live := range snapshots {
+			exportable, _ := live["exportable"].(bool)
+			snapshot := &SnapshotSpec{
+				Kind:       KindSnapshot,
+				SnapshotId: api.StringValue(live, "snapshot_id"),
+				Instance:   instance.Name,
+				Profile:    api.StringValue(live, "profile"),
+				Status:     api.StringValue(live, "status"),
+				Timestamp:  api.StringValue(live, "timestamp"),
+				Exportable: exportable,
+			}
+			if _, err := e.write(filepath.Join(instanceDir, "snapshots"), snapshot.SnapshotId, snapshot.SnapshotId, snapshot.Kind, snapshot); err != nil {
+				return nil, err
+			}
+		}
+
+		if !cfg.Aura.AuraBetaEnabled() {
+			continue
+		}
+
+		graphQLDataApis, err := api.ListGraphQLDataApis(cfg, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, live := range graphQLDataApis {
+			if err := e.writeGraphQLDataApi(cfg, filepath.Join(instanceDir, "graphql-data-apis"), instance.Name, id, api.StringValue(live, "id")); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return e.files, nil
+}
+
+func (e *exporter) writeGraphQLDataApi(cfg *clicfg.Config, dir string, instanceName string, instanceId string, id string) error {
+	details, err := api.GetGraphQLDataApi(cfg, instanceId, id)
+	if err != nil {
+		return err
+	}
+
+	liveAuthProviders, err := api.ListAuthProviders(cfg, instanceId, id)
+	if err != nil {
+		return err
+	}
+	authProviders := []AuthProviderSpec{}
+	for _, live := range liveAuthProviders {
+		enabled, _ := live["enabled"].(bool)
+		authProviders = append(authProviders, AuthProviderSpec{
+			Name:    api.StringValue(live, "name"),
+			Type:    api.StringValue(live, "type"),
+			Enabled: enabled,
+			Url:     api.StringValue(live, "url"),
+		})
+	}
+
+	typeDefs, err := base64.StdEncoding.DecodeString(api.StringValue(details, "type_definitions"))
+	if err != nil {
+		return clierr.NewUpstreamError("invalid type definitions of GraphQL Data API %s: %w", id, err)
+	}
+
+	name := api.StringValue(details, "name")
+	baseName := e.reserve(dir, name, id)
+	graphQLDataApi := &GraphQLDataApiSpec{
+		Kind:                KindGraphQLDataApi,
+		Name:                name,
+		Instance:            instanceName,
+		TypeDefinitionsFile: baseName + ".graphql",
+		AuthProviders:       authProviders,
+	}
+
+	if err := e.writeFile(filepath.Join(dir, baseName+".graphql"), typeDefs); err != nil {
+		return err
+	}
+	return e.writeSpec(dir, baseName, graphQLDataApi.Kind, name, graphQLDataApi)
+}
+
+// Writes a resource to a file named after it, falling back to its ID when the name is already taken in the directory.
+// Returns the file name without its extension.
+func (e *exporter) write(dir string, name string, id string, kind string, resource any) (string, error) {
+	baseName := e.reserve(dir, name, id)
+	return baseName, e.writeSpec(dir, baseName, kind, name, resource)
+}
+
+func (e *exporter) writeSpec(dir string, baseName string, kind string, name string, resource any) error {
+	var data []byte
+	if e.format == ExportFormatJson {
+		encoded, err := json.MarshalIndent(resource, "", "\t")
+		if err != nil {
+			return err
+		}
+		data = append(encoded, '\n')
+	} else {
+		var buffer bytes.Buffer
+		encoder := yaml.NewEncoder(&buffer)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(resource); err != nil {
+			return err
+		}
+		encoder.Close()
+		data = buffer.Bytes()
+	}
+
+	file := filepath.Join(dir, baseName+"."+e.format)
+	if err := e.writeFile(file, data); err != nil {
+		return err
+	}
+	e.files = append(e.files, ExportedFile{Kind: kind, Name: name, File: filepath.Join(e.dir, file)})
+	return nil
+}
+
+func (e *exporter) writeFile(file string, data []byte) error {
+	path := filepath.Join(e.dir, file)
+	if err := e.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return clierr.NewFatalError("unable to create directory for %s: %w", path, err)
+	}
+	if err := afero.WriteFile(e.fs, path, data, 0644); err != nil {
+		return clierr.NewFatalError("unable to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Reserves the file name of a resource in a directory
+func (e *exporter) reserve(dir string, name string, id string) string {
+	if e.used[dir] == nil {
+		e.used[dir] = map[string]bool{}
+	}
+	baseName := fileName(name)
+	if baseName == "" || e.used[dir][baseName] {
+		baseName = fileName(name + "-" + id)
+	}
+	e.used[dir][baseName] = true
+	return baseName
+}
+
+func fileName(name string) string {
+	return unsafeFileNameCharacters.ReplaceAllString(name, "-")
+}
@@ -0,0 +1,416 @@
+package declarative
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionReplace = "replace"
+	ActionDelete  = "delete"
+)
+
+type Change struct {
+	Field  string
+	Before any
+	After  any
+}
+
+type Action struct {
+	Action  string
+	Kind    string
+	Name    string
+	Parent  string
+	Changes []Change
+
+	// ID of the live resource, and of the resources it belongs to
+	id               string
+	instanceId       string
+	graphQLDataApiId string
+
+	instance       *InstanceSpec
+	cmk            *CustomerManagedKeySpec
+	graphQLDataApi *GraphQLDataApiSpec
+	authProvider   *AuthProviderSpec
+	body           map[string]any
+}
+
+// The actions needed for the live resources to converge with the spec, in the order they must be applied
+type Plan struct {
+	Actions []*Action
+
+	spec *Spec
+	// Live IDs by instance name, and by tenant ID and customer managed key name
+	instanceIds map[string]string
+	cmkIds      map[string]string
+}
+
+// Compares the spec with the live resources of the tenants it declares resources in.
+// With prune, live resources that are not declared are deleted, limited to the kinds of resources present in the spec.
+func NewPlan(cfg *clicfg.Config, spec *Spec, prune bool) (*Plan, error) {
+	plan := &Plan{
+		spec:        spec,
+		instanceIds: map[string]string{},
+		cmkIds:      map[string]string{},
+	}
+	errs := []string{}
+
+	if len(spec.GraphQLDataApis) > 0 && !cfg.Aura.AuraBetaEnabled() {
+		return nil, clierr.NewUsageError("%s resources require the beta to be enabled, use the config set subcommand to set beta-enabled to true", KindGraphQLDataApi)
+	}
+
+	tenants := []string{}
+	for _, instance := range spec.Instances {
+		if instance.TenantId == "" {
+			instance.TenantId = cfg.Aura.DefaultTenant()
+		}
+		if instance.TenantId == "" {
+			return nil, clierr.NewUsageError("%s %s has no tenant_id and no default tenant is configured", KindInstance, instance.Name)
+		}
+		if !slices.Contains(tenants, instance.TenantId) {
+			tenants = append(tenants, instance.TenantId)
+		}
+	}
+	for _, cmk := range spec.CustomerManagedKeys {
+		if cmk.TenantId == "" {
+			cmk.TenantId = cfg.Aura.DefaultTenant()
+		}
+		if cmk.TenantId == "" {
+			return nil, clierr.NewUsageError("%s %s has no tenant_id and no default tenant is configured", KindCustomerManagedKey, cmk.Name)
+		}
+		if !slices.Contains(tenants, cmk.TenantId) {
+			tenants = append(tenants, cmk.TenantId)
+		}
+	}
+
+	creates, updates, deletes := []*Action{}, []*Action{}, []*Action{}
+
+	// Customer managed keys are planned first, as instances can reference them
+	for _, tenantId := range tenants {
+		liveCMKs, err := api.ListCMKs(cfg, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		matched := map[string]bool{}
+		for _, cmk := range spec.CustomerManagedKeys {
+			if cmk.TenantId != tenantId {
+				continue
+			}
+			live, err := api.FindByName(liveCMKs, KindCustomerManagedKey, cmk.Name)
+			if err != nil {
+				return nil, err
+			}
+			if live == nil {
+				creates = append(creates, &Action{Action: ActionCreate, Kind: KindCustomerManagedKey, Name: cmk.Name, Parent: tenantId, cmk: cmk})
+				continue
+			}
+			id := api.StringValue(live, "id")
+			matched[id] = true
+			plan.cmkIds[tenantId+"/"+cmk.Name] = id
+
+			details, err := api.GetCMK(cfg, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, change := range compare(details, map[string]string{"type": cmk.Type, "cloud_provider": cmk.CloudProvider, "region": cmk.Region, "key_id": cmk.KeyId}) {
+				errs = append(errs, fmt.Sprintf("%s of %s %s cannot be changed from %v to %v", change.Field, KindCustomerManagedKey, cmk.Name, change.Before, change.After))
+			}
+		}
+		if prune && len(spec.CustomerManagedKeys) > 0 {
+			for _, live := range liveCMKs {
+				if id := api.StringValue(live, "id"); !matched[id] {
+					deletes = append(deletes, &Action{Action: ActionDelete, Kind: KindCustomerManagedKey, Name: api.StringValue(live, "name"), Parent: tenantId, id: id})
+				}
+			}
+		}
+	}
+
+	instanceDeletes := []*Action{}
+	for _, tenantId := range tenants {
+		liveInstances, err := api.ListInstances(cfg, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		matched := map[string]bool{}
+		for _, instance := range spec.Instances {
+			if instance.TenantId != tenantId {
+				continue
+			}
+			live, err := api.FindByName(liveInstances, KindInstance, instance.Name)
+			if err != nil {
+				return nil, err
+			}
+			if live == nil {
+				if err := validateInstanceCreate(instance); err != nil {
+					errs = append(errs, err.Error())
+				}
+				if _, planned := plan.plannedCMK(instance); instance.CustomerManagedKey != "" && !planned {
+					if err := plan.resolveCMK(cfg, instance); err != nil {
+						return nil, err
+					}
+				}
+				creates = append(creates, &Action{Action: ActionCreate, Kind: KindInstance, Name: instance.Name, Parent: tenantId, instance: instance})
+				continue
+			}
+			id := api.StringValue(live, "id")
+			matched[id] = true
+			plan.instanceIds[instance.Name] = id
+
+			details, err := api.GetInstance(cfg, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, change := range compare(details, map[string]string{"type": instance.Type, "cloud_provider": instance.CloudProvider, "region": instance.Region}) {
+				errs = append(errs, fmt.Sprintf("%s of %s %s cannot be changed from %v to %v", change.Field, KindInstance, instance.Name, change.Before, change.After))
+			}
+			if instance.CustomerManagedKey != "" {
+				if _, planned := plan.plannedCMK(instance); planned {
+					errs = append(errs, fmt.Sprintf("customer_managed_key of %s %s cannot be changed to %s, which does not exist yet", KindInstance, instance.Name, instance.CustomerManagedKey))
+				} else {
+					if err := plan.resolveCMK(cfg, instance); err != nil {
+						return nil, err
+					}
+					for _, change := range compare(details, map[string]string{"customer_managed_key_id": plan.cmkIds[instance.TenantId+"/"+instance.CustomerManagedKey]}) {
+						errs = append(errs, fmt.Sprintf("customer_managed_key of %s %s cannot be changed from %v to %v", KindInstance, instance.Name, change.Before, instance.CustomerManagedKey))
+					}
+				}
+			}
+			if changes := compare(details, map[string]string{"memory": instance.Memory}); len(changes) > 0 {
+				updates = append(updates, &Action{Action: ActionUpdate, Kind: KindInstance, Name: instance.Name, Parent: tenantId, Changes: changes, id: id, body: map[string]any{"memory": instance.Memory}})
+			}
+		}
+		if prune && len(spec.Instances) > 0 {
+			for _, live := range liveInstances {
+				if id := api.StringValue(live, "id"); !matched[id] {
+					instanceDeletes = append(instanceDeletes, &Action{Action: ActionDelete, Kind: KindInstance, Name: api.StringValue(live, "name"), Parent: tenantId, id: id})
+				}
+			}
+		}
+	}
+
+	graphQLDeletes := []*Action{}
+	if len(spec.GraphQLDataApis) > 0 {
+		for _, instance := range spec.Instances {
+			instanceId, exists := plan.instanceIds[instance.Name]
+			liveGraphQLDataApis := []map[string]any{}
+			if exists {
+				var err error
+				liveGraphQLDataApis, err = api.ListGraphQLDataApis(cfg, instanceId)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			matched := map[string]bool{}
+			for _, graphQLDataApi := range spec.GraphQLDataApis {
+				if graphQLDataApi.Instance != instance.Name {
+					continue
+				}
+				live, err := api.FindByName(liveGraphQLDataApis, KindGraphQLDataApi, graphQLDataApi.Name)
+				if err != nil {
+					return nil, err
+				}
+				if live == nil {
+					if graphQLDataApi.InstanceUsername == "" || graphQLDataApi.InstancePassword == "" {
+						errs = append(errs, fmt.Sprintf("%s %s must have an instance_username and instance_password to be created", KindGraphQLDataApi, graphQLDataApi.Name))
+					}
+					creates = append(creates, &Action{Action: ActionCreate, Kind: KindGraphQLDataApi, Name: graphQLDataApi.Name, Parent: instance.Name, graphQLDataApi: graphQLDataApi})
+					continue
+				}
+				id := api.StringValue(live, "id")
+				matched[id] = true
+
+				actions, err := planGraphQLDataApiChanges(cfg, instanceId, id, graphQLDataApi, prune)
+				if err != nil {
+					return nil, err
+				}
+				updates = append(updates, actions...)
+			}
+			if prune {
+				for _, live := range liveGraphQLDataApis {
+					if id := api.StringValue(live, "id"); !matched[id] {
+						graphQLDeletes = append(graphQLDeletes, &Action{Action: ActionDelete, Kind: KindGraphQLDataApi, Name: api.StringValue(live, "name"), Parent: instance.Name, id: id, instanceId: instanceId})
+					}
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, clierr.NewUsageError("the spec cannot be applied:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+
+	// Dependent resources are deleted before the resources they depend on
+	plan.Actions = append(plan.Actions, creates...)
+	plan.Actions = append(plan.Actions, updates...)
+	plan.Actions = append(plan.Actions, graphQLDeletes...)
+	plan.Actions = append(plan.Actions, instanceDeletes...)
+	plan.Actions = append(plan.Actions, deletes...)
+
+	return plan, nil
+}
+
+func planGraphQLDataApiChanges(cfg *clicfg.Config, instanceId string, graphQLDataApiId string, graphQLDataApi *GraphQLDataApiSpec, prune bool) ([]*Action, error) {
+	actions := []*Action{}
+
+	details, err := api.GetGraphQLDataApi(cfg, instanceId, graphQLDataApiId)
+	if err != nil {
+		return nil, err
+	}
+	liveTypeDefs, err := base64.StdEncoding.DecodeString(api.StringValue(details, "type_definitions"))
+	if err != nil {
+		return nil, clierr.NewUpstreamError("type definitions of %s %s are not valid base64", KindGraphQLDataApi, graphQLDataApi.Name)
+	}
+	if strings.TrimSpace(string(liveTypeDefs)) != strings.TrimSpace(graphQLDataApi.typeDefinitions) {
+		actions = append(actions, &Action{
+			Action:           ActionUpdate,
+			Kind:             KindGraphQLDataApi,
+			Name:             graphQLDataApi.Name,
+			Parent:           graphQLDataApi.Instance,
+			Changes:          []Change{{Field: "type_definitions", Before: "(live)", After: graphQLDataApi.TypeDefinitionsFile}},
+			id:               graphQLDataApiId,
+			instanceId:       instanceId,
+			graphQLDataApi:   graphQLDataApi,
+			graphQLDataApiId: graphQLDataApiId,
+			body:             map[string]any{"type_definitions": base64.StdEncoding.EncodeToString([]byte(graphQLDataApi.typeDefinitions))},
+		})
+	}
+
+	liveAuthProviders, err := api.ListAuthProviders(cfg, instanceId, graphQLDataApiId)
+	if err != nil {
+		return nil, err
+	}
+	parent := fmt.Sprintf("%s/%s", graphQLDataApi.Instance, graphQLDataApi.Name)
+	matched := map[string]bool{}
+	for i := range graphQLDataApi.AuthProviders {
+		authProvider := &graphQLDataApi.AuthProviders[i]
+		live, err := api.FindByName(liveAuthProviders, KindAuthProvider, authProvider.Name)
+		if err != nil {
+			return nil, err
+		}
+		action := &Action{Kind: KindAuthProvider, Name: authProvider.Name, Parent: parent, instanceId: instanceId, graphQLDataApiId: graphQLDataApiId, authProvider: authProvider}
+		if live == nil {
+			action.Action = ActionCreate
+			actions = append(actions, action)
+			continue
+		}
+		id := api.StringValue(live, "id")
+		matched[id] = true
+
+		// Authentication providers cannot be updated, so are replaced when they differ
+		changes := compare(live, map[string]string{"type": authProvider.Type, "url": authProvider.Url})
+		if enabled, _ := live["enabled"].(bool); enabled != authProvider.Enabled {
+			changes = append(changes, Change{Field: "enabled", Before: enabled, After: authProvider.Enabled})
+		}
+		if len(changes) > 0 {
+			action.Action = ActionReplace
+			action.Changes = changes
+			action.id = id
+			actions = append(actions, action)
+		}
+	}
+	if prune && len(graphQLDataApi.AuthProviders) > 0 {
+		for _, live := range liveAuthProviders {
+			if id := api.StringValue(live, "id"); !matched[id] {
+				actions = append(actions, &Action{Action: ActionDelete, Kind: KindAuthProvider, Name: api.StringValue(live, "name"), Parent: parent, id: id, instanceId: instanceId, graphQLDataApiId: graphQLDataApiId})
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// Returns the customer managed key declared in the spec that the instance references, if any
+func (plan *Plan) plannedCMK(instance *InstanceSpec) (*CustomerManagedKeySpec, bool) {
+	for _, cmk := range plan.spec.CustomerManagedKeys {
+		if cmk.Name == instance.CustomerManagedKey && cmk.TenantId == instance.TenantId {
+			_, exists := plan.cmkIds[cmk.TenantId+"/"+cmk.Name]
+			return cmk, !exists
+		}
+	}
+	return nil, false
+}
+
+// Resolves the customer managed key referenced by an instance to the ID of a live key, looking it up by name and otherwise using it as an ID
+func (plan *Plan) resolveCMK(cfg *clicfg.Config, instance *InstanceSpec) error {
+	key := instance.TenantId + "/" + instance.CustomerManagedKey
+	if _, resolved := plan.cmkIds[key]; resolved {
+		return nil
+	}
+
+	liveCMKs, err := api.ListCMKs(cfg, instance.TenantId)
+	if err != nil {
+		return err
+	}
+	live, err := api.FindByName(liveCMKs, KindCustomerManagedKey, instance.CustomerManagedKey)
+	if err != nil {
+		return err
+	}
+	if live != nil {
+		plan.cmkIds[key] = api.StringValue(live, "id")
+	} else {
+		plan.cmkIds[key] = instance.CustomerManagedKey
+	}
+	return nil
+}
+
+// Rows describing the plan, for printing
+func (plan *Plan) AsResponseData() api.ResponseData {
+	rows := []map[string]any{}
+	for _, action := range plan.Actions {
+		changes := []string{}
+		for _, change := range action.Changes {
+			changes = append(changes, fmt.Sprintf("%s: %v → %v", change.Field, change.Before, change.After))
+		}
+		rows = append(rows, map[string]any{
+			"action":  action.Action,
+			"kind":    action.Kind,
+			"name":    action.Name,
+			"parent":  action.Parent,
+			"changes": strings.Join(changes, ", "),
+		})
+	}
+	return api.NewResponseData(rows)
+}
+
+func validateInstanceCreate(instance *InstanceSpec) error {
+	if instance.Type == "free-db" {
+		return nil
+	}
+	missing := []string{}
+	for field, value := range map[string]string{"memory": instance.Memory, "region": instance.Region, "cloud_provider": instance.CloudProvider} {
+		if value == "" {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return fmt.Errorf("%s %s must have %s to be created", KindInstance, instance.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Changes for the desired fields that are set and differ from the live resource, in a stable order
+func compare(live map[string]any, desired map[string]string) []Change {
+	fields := []string{}
+	for field := range desired {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	changes := []Change{}
+	for _, field := range fields {
+		if desired[field] != "" && desired[field] != api.StringValue(live, field) {
+			changes = append(changes, Change{Field: field, Before: live[field], After: desired[field]})
+		}
+	}
+	return changes
+}
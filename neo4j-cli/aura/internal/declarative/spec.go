@@ -0,0 +1,248 @@
+package declarative
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/neo4j/cli/common/clierr"
+)
+
+const (
+	KindInstance           = "Instance"
+	KindCustomerManagedKey = "CustomerManagedKey"
+	KindGraphQLDataApi     = "GraphQLDataApi"
+	KindAuthProvider       = "AuthProvider"
+	// Snapshots are exported for reference only, they are read but not managed by a spec
+	KindSnapshot = "Snapshot"
+)
+
+type InstanceSpec struct {
+	Kind          string `yaml:"kind" json:"kind"`
+	Name          string `yaml:"name" json:"name"`
+	TenantId      string `yaml:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	Type          string `yaml:"type" json:"type"`
+	Version       string `yaml:"version,omitempty" json:"version,omitempty"`
+	CloudProvider string `yaml:"cloud_provider,omitempty" json:"cloud_provider,omitempty"`
+	Region        string `yaml:"region,omitempty" json:"region,omitempty"`
+	Memory        string `yaml:"memory,omitempty" json:"memory,omitempty"`
+	// Name or ID of the customer managed key used to encrypt the instance
+	CustomerManagedKey string `yaml:"customer_managed_key,omitempty" json:"customer_managed_key,omitempty"`
+}
+
+type CustomerManagedKeySpec struct {
+	Kind          string `yaml:"kind" json:"kind"`
+	Name          string `yaml:"name" json:"name"`
+	TenantId      string `yaml:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	Type          string `yaml:"type" json:"type"`
+	CloudProvider string `yaml:"cloud_provider" json:"cloud_provider"`
+	Region        string `yaml:"region" json:"region"`
+	KeyId         string `yaml:"key_id" json:"key_id"`
+}
+
+type GraphQLDataApiSpec struct {
+	Kind string `yaml:"kind" json:"kind"`
+	Name string `yaml:"name" json:"name"`
+	// Name of the instance the GraphQL Data API is connected to
+	Instance string `yaml:"instance" json:"instance"`
+	// Path to the type definitions, relative to the file the GraphQL Data API is declared in
+	TypeDefinitionsFile string             `yaml:"type_definitions_file" json:"type_definitions_file"`
+	InstanceUsername    string             `yaml:"instance_username,omitempty" json:"instance_username,omitempty"`
+	InstancePassword    string             `yaml:"instance_password,omitempty" json:"instance_password,omitempty"`
+	AuthProviders       []AuthProviderSpec `yaml:"auth_providers,omitempty" json:"auth_providers,omitempty"`
+
+	typeDefinitions string
+}
+
+type SnapshotSpec struct {
+	Kind       string `yaml:"kind" json:"kind"`
+	SnapshotId string `yaml:"snapshot_id" json:"snapshot_id"`
+	Instance   string `yaml:"instance" json:"instance"`
+	Profile    string `yaml:"profile,omitempty" json:"profile,omitempty"`
+	Status     string `yaml:"status,omitempty" json:"status,omitempty"`
+	Timestamp  string `yaml:"timestamp,omitempty" json:"timestamp,omitempty"`
+	Exportable bool   `yaml:"exportable" json:"exportable"`
+}
+
+type AuthProviderSpec struct {
+	Name    string `yaml:"name" json:"name"`
+	Type    string `yaml:"type" json:"type"`
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	Url     string `yaml:"url,omitempty" json:"url,omitempty"`
+}
+
+// The desired state of Aura resources, as declared in one or more spec files
+type Spec struct {
+	Instances           []*InstanceSpec
+	CustomerManagedKeys []*CustomerManagedKeySpec
+	GraphQLDataApis     []*GraphQLDataApiSpec
+}
+
+// Loads the spec from a file, or from every .yaml, .yml and .json file below a directory.
+// Environment variables in the files, such as ${INSTANCE_PASSWORD}, are expanded.
+func Load(fs afero.Fs, path string) (*Spec, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return nil, clierr.NewUsageError("cannot read spec %s: %w", path, err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files = []string{}
+		err := afero.Walk(fs, path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(file)) {
+			case ".yaml", ".yml", ".json":
+				if !info.IsDir() {
+					files = append(files, file)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, clierr.NewUsageError("cannot read spec directory %s: %w", path, err)
+		}
+		sort.Strings(files)
+	}
+
+	spec := &Spec{}
+	for _, file := range files {
+		if err := spec.loadFile(fs, file); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+func (spec *Spec) loadFile(fs afero.Fs, file string) error {
+	data, err := afero.ReadFile(fs, file)
+	if err != nil {
+		return clierr.NewUsageError("cannot read spec file %s: %w", file, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(os.ExpandEnv(string(data)))))
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return clierr.NewUsageError("invalid spec file %s: %w", file, err)
+		}
+
+		var header struct {
+			Kind string `yaml:"kind"`
+		}
+		if err := document.Decode(&header); err != nil {
+			return clierr.NewUsageError("invalid spec file %s: %w", file, err)
+		}
+
+		switch header.Kind {
+		case KindInstance:
+			var instance InstanceSpec
+			if err := document.Decode(&instance); err != nil {
+				return clierr.NewUsageError("invalid instance in spec file %s: %w", file, err)
+			}
+			spec.Instances = append(spec.Instances, &instance)
+		case KindCustomerManagedKey:
+			var cmk CustomerManagedKeySpec
+			if err := document.Decode(&cmk); err != nil {
+				return clierr.NewUsageError("invalid customer managed key in spec file %s: %w", file, err)
+			}
+			spec.CustomerManagedKeys = append(spec.CustomerManagedKeys, &cmk)
+		case KindGraphQLDataApi:
+			var graphQLDataApi GraphQLDataApiSpec
+			if err := document.Decode(&graphQLDataApi); err != nil {
+				return clierr.NewUsageError("invalid GraphQL Data API in spec file %s: %w", file, err)
+			}
+			if graphQLDataApi.TypeDefinitionsFile == "" {
+				return clierr.NewUsageError("GraphQL Data API %s in spec file %s has no type_definitions_file", graphQLDataApi.Name, file)
+			}
+			typeDefsPath := graphQLDataApi.TypeDefinitionsFile
+			if !filepath.IsAbs(typeDefsPath) {
+				typeDefsPath = filepath.Join(filepath.Dir(file), typeDefsPath)
+			}
+			typeDefs, err := afero.ReadFile(fs, typeDefsPath)
+			if err != nil {
+				return clierr.NewUsageError("cannot read type definitions of GraphQL Data API %s: %w", graphQLDataApi.Name, err)
+			}
+			graphQLDataApi.typeDefinitions = string(typeDefs)
+			spec.GraphQLDataApis = append(spec.GraphQLDataApis, &graphQLDataApi)
+		case KindSnapshot:
+		case "":
+			// Empty documents, such as a trailing document separator, are ignored
+			if document.Kind == yaml.DocumentNode && len(document.Content) > 0 && document.Content[0].Kind == yaml.MappingNode && len(document.Content[0].Content) > 0 {
+				return clierr.NewUsageError("resource in spec file %s has no kind", file)
+			}
+		default:
+			return clierr.NewUsageError("unknown kind %s in spec file %s, must be one of %s, %s or %s", header.Kind, file, KindInstance, KindCustomerManagedKey, KindGraphQLDataApi)
+		}
+	}
+}
+
+// Checks that every resource is named and that names are unique within their scope
+func (spec *Spec) validate() error {
+	instances := map[string]bool{}
+	for _, instance := range spec.Instances {
+		if instance.Name == "" {
+			return clierr.NewUsageError("every %s must have a name", KindInstance)
+		}
+		if instance.Type == "" {
+			return clierr.NewUsageError("%s %s must have a type", KindInstance, instance.Name)
+		}
+		if instances[instance.Name] {
+			return clierr.NewUsageError("%s %s is declared more than once", KindInstance, instance.Name)
+		}
+		instances[instance.Name] = true
+	}
+
+	cmks := map[string]bool{}
+	for _, cmk := range spec.CustomerManagedKeys {
+		if cmk.Name == "" {
+			return clierr.NewUsageError("every %s must have a name", KindCustomerManagedKey)
+		}
+		if cmks[cmk.Name] {
+			return clierr.NewUsageError("%s %s is declared more than once", KindCustomerManagedKey, cmk.Name)
+		}
+		cmks[cmk.Name] = true
+	}
+
+	graphQLDataApis := map[string]bool{}
+	for _, graphQLDataApi := range spec.GraphQLDataApis {
+		if graphQLDataApi.Name == "" {
+			return clierr.NewUsageError("every %s must have a name", KindGraphQLDataApi)
+		}
+		if !instances[graphQLDataApi.Instance] {
+			return clierr.NewUsageError("%s %s must reference an instance declared in the spec, found %s", KindGraphQLDataApi, graphQLDataApi.Name, graphQLDataApi.Instance)
+		}
+		key := graphQLDataApi.Instance + "/" + graphQLDataApi.Name
+		if graphQLDataApis[key] {
+			return clierr.NewUsageError("%s %s of instance %s is declared more than once", KindGraphQLDataApi, graphQLDataApi.Name, graphQLDataApi.Instance)
+		}
+		graphQLDataApis[key] = true
+
+		authProviders := map[string]bool{}
+		for _, authProvider := range graphQLDataApi.AuthProviders {
+			if authProviders[authProvider.Name] {
+				return clierr.NewUsageError("authentication provider %s of %s %s is declared more than once", authProvider.Name, KindGraphQLDataApi, graphQLDataApi.Name)
+			}
+			authProviders[authProvider.Name] = true
+		}
+	}
+
+	return nil
+}
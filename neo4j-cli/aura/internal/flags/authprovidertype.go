@@ -1,6 +1,11 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
+
+var authProviderTypeValues = []string{"api-key", "jwks"}
 
 type AuthProviderType string
 
@@ -11,16 +16,19 @@ func (e *AuthProviderType) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *AuthProviderType) Set(v string) error {
-	switch v {
-	case "api-key", "jwks":
-		*e = AuthProviderType(v)
-		return nil
-	default:
+	if !slices.Contains(authProviderTypeValues, v) {
 		return errors.New(`must be one of "api-key" or "jwks"`)
 	}
+	*e = AuthProviderType(v)
+	return nil
 }
 
 // Type is only used in help text
 func (e *AuthProviderType) Type() string {
 	return "type"
 }
+
+// Values lists the valid values, such as for completion
+func (e *AuthProviderType) Values() []string {
+	return slices.Clone(authProviderTypeValues)
+}
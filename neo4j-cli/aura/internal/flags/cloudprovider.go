@@ -1,6 +1,11 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
+
+var cloudProviderValues = []string{"aws", "azure", "gcp"}
 
 type CloudProvider string
 
@@ -11,16 +16,19 @@ func (e *CloudProvider) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *CloudProvider) Set(v string) error {
-	switch v {
-	case "aws", "azure", "gcp":
-		*e = CloudProvider(v)
-		return nil
-	default:
+	if !slices.Contains(cloudProviderValues, v) {
 		return errors.New(`must be one of "aws", "azure", or "gcp"`)
 	}
+	*e = CloudProvider(v)
+	return nil
 }
 
 // Type is only used in help text
 func (e *CloudProvider) Type() string {
 	return "cloud-provider"
 }
+
+// Values lists the valid values, such as for completion
+func (e *CloudProvider) Values() []string {
+	return slices.Clone(cloudProviderValues)
+}
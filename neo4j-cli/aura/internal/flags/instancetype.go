@@ -1,6 +1,11 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
+
+var instanceTypeValues = []string{"free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", "enterprise-ds"}
 
 type InstanceType string
 
@@ -11,16 +16,19 @@ func (e *InstanceType) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *InstanceType) Set(v string) error {
-	switch v {
-	case "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", "enterprise-ds":
-		*e = InstanceType(v)
-		return nil
-	default:
+	if !slices.Contains(instanceTypeValues, v) {
 		return errors.New(`must be one of "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", or "enterprise-ds"`)
 	}
+	*e = InstanceType(v)
+	return nil
 }
 
 // Type is only used in help text
 func (e *InstanceType) Type() string {
 	return "type"
 }
+
+// Values lists the valid values, such as for completion
+func (e *InstanceType) Values() []string {
+	return slices.Clone(instanceTypeValues)
+}
@@ -1,6 +1,11 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
+
+var memoryValues = []string{"1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", "512GB"}
 
 type Memory string
 
@@ -11,16 +16,19 @@ func (e *Memory) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *Memory) Set(v string) error {
-	switch v {
-	case "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", "512GB":
-		*e = Memory(v)
-		return nil
-	default:
+	if !slices.Contains(memoryValues, v) {
 		return errors.New(`must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"`)
 	}
+	*e = Memory(v)
+	return nil
 }
 
 // Type is only used in help text
 func (e *Memory) Type() string {
 	return "memory"
 }
+
+// Values lists the valid values, such as for completion
+func (e *Memory) Values() []string {
+	return slices.Clone(memoryValues)
+}
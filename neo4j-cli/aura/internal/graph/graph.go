@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const (
+	FormatDot     = "dot"
+	FormatMermaid = "mermaid"
+	FormatCypher  = "cypher"
+)
+
+var ValidFormats = []string{FormatDot, FormatMermaid, FormatCypher}
+
+// Node labels
+const (
+	LabelTenant             = "Tenant"
+	LabelInstance           = "Instance"
+	LabelCustomerManagedKey = "CustomerManagedKey"
+	LabelGraphQLDataApi     = "GraphQLDataApi"
+	LabelAuthProvider       = "AuthProvider"
+)
+
+// Relationship types
+const (
+	HasInstance           = "HAS_INSTANCE"
+	HasCustomerManagedKey = "HAS_CUSTOMER_MANAGED_KEY"
+	Encrypts              = "ENCRYPTS"
+	HasGraphQLDataApi     = "HAS_GRAPHQL_DATA_API"
+	HasAuthProvider       = "HAS_AUTH_PROVIDER"
+)
+
+type Node struct {
+	Label string
+	Id    string
+	Name  string
+	// Further properties, such as the type and region of an instance
+	Properties map[string]string
+}
+
+type Relationship struct {
+	Type string
+	From *Node
+	To   *Node
+}
+
+// The topology of Aura resources
+type Graph struct {
+	Nodes         []*Node
+	Relationships []Relationship
+}
+
+// Builds the graph of the given tenants, or of every tenant if none is given:
+//
+//	(Tenant)-[:HAS_INSTANCE]->(Instance)
+//	(Tenant)-[:HAS_CUSTOMER_MANAGED_KEY]->(CustomerManagedKey)-[:ENCRYPTS]->(Instance)
+//	(Instance)-[:HAS_GRAPHQL_DATA_API]->(GraphQLDataApi)-[:HAS_AUTH_PROVIDER]->(AuthProvider)
+//
+// GraphQL Data APIs and their authentication providers are only added when the beta is enabled.
+func Build(cfg *clicfg.Config, tenantIds []string) (*Graph, error) {
+	tenants := []map[string]any{}
+	if len(tenantIds) == 0 {
+		var err error
+		tenants, err = api.ListTenants(cfg)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, tenantId := range tenantIds {
+			tenant, err := api.GetTenant(cfg, tenantId)
+			if err != nil {
+				return nil, err
+			}
+			tenants = append(tenants, tenant)
+		}
+	}
+
+	graph := &Graph{Nodes: []*Node{}, Relationships: []Relationship{}}
+	for _, tenant := range tenants {
+		if err := graph.addTenant(cfg, tenant); err != nil {
+			return nil, err
+		}
+	}
+	return graph, nil
+}
+
+func (graph *Graph) addTenant(cfg *clicfg.Config, tenant map[string]any) error {
+	tenantNode := graph.addNode(LabelTenant, tenant, nil)
+
+	cmks, err := api.ListCMKs(cfg, tenantNode.Id)
+	if err != nil {
+		return err
+	}
+	cmkNodes := map[string]*Node{}
+	for _, cmk := range cmks {
+		cmkNode := graph.addNode(LabelCustomerManagedKey, cmk, nil)
+		cmkNodes[cmkNode.Id] = cmkNode
+		graph.addRelationship(HasCustomerManagedKey, tenantNode, cmkNode)
+	}
+
+	instances, err := api.ListInstances(cfg, tenantNode.Id)
+	if err != nil {
+		return err
+	}
+	details, err := api.GetInstances(cfg, instances)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range details {
+		instanceNode := graph.addNode(LabelInstance, instance, []string{"type", "cloud_provider", "region", "status"})
+		graph.addRelationship(HasInstance, tenantNode, instanceNode)
+
+		if cmkNode, ok := cmkNodes[api.StringValue(instance, "customer_managed_key_id")]; ok {
+			graph.addRelationship(Encrypts, cmkNode, instanceNode)
+		}
+
+		if cfg.Aura.AuraBetaEnabled() {
+			if err := graph.addGraphQLDataApis(cfg, instanceNode); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (graph *Graph) addGraphQLDataApis(cfg *clicfg.Config, instanceNode *Node) error {
+	graphQLDataApis, err := api.ListGraphQLDataApis(cfg, instanceNode.Id)
+	if err != nil {
+		return err
+	}
+	for _, graphQLDataApi := range graphQLDataApis {
+		graphQLDataApiNode := graph.addNode(LabelGraphQLDataApi, graphQLDataApi, []string{"status", "url"})
+		graph.addRelationship(HasGraphQLDataApi, instanceNode, graphQLDataApiNode)
+
+		authProviders, err := api.ListAuthProviders(cfg, instanceNode.Id, graphQLDataApiNode.Id)
+		if err != nil {
+			return err
+		}
+		for _, authProvider := range authProviders {
+			authProviderNode := graph.addNode(LabelAuthProvider, authProvider, []string{"type"})
+			graph.addRelationship(HasAuthProvider, graphQLDataApiNode, authProviderNode)
+		}
+	}
+	return nil
+}
+
+func (graph *Graph) addNode(label string, resource map[string]any, properties []string) *Node {
+	node := &Node{
+		Label:      label,
+		Id:         api.StringValue(resource, "id"),
+		Name:       api.StringValue(resource, "name"),
+		Properties: map[string]string{},
+	}
+	for _, property := range properties {
+		if value := api.StringValue(resource, property); value != "" {
+			node.Properties[property] = value
+		}
+	}
+	graph.Nodes = append(graph.Nodes, node)
+	return node
+}
+
+func (graph *Graph) addRelationship(relationshipType string, from *Node, to *Node) {
+	graph.Relationships = append(graph.Relationships, Relationship{Type: relationshipType, From: from, To: to})
+}
@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var unsafeIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// Writes the graph as a Graphviz DOT digraph, a Mermaid flowchart or a Cypher script that recreates it in a Neo4j database
+func (graph *Graph) Write(w io.Writer, format string) error {
+	var b strings.Builder
+	switch format {
+	case FormatMermaid:
+		graph.writeMermaid(&b)
+	case FormatCypher:
+		graph.writeCypher(&b)
+	default:
+		graph.writeDot(&b)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (graph *Graph) writeDot(b *strings.Builder) {
+	b.WriteString("digraph aura {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(b, "\t%s [label=%s];\n", dotString(identifier(node)), dotString(node.Label+"\n"+node.Name))
+	}
+	for _, relationship := range graph.Relationships {
+		fmt.Fprintf(b, "\t%s -> %s [label=%s];\n", dotString(identifier(relationship.From)), dotString(identifier(relationship.To)), dotString(relationship.Type))
+	}
+	b.WriteString("}\n")
+}
+
+func (graph *Graph) writeMermaid(b *strings.Builder) {
+	b.WriteString("flowchart LR\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(b, "\t%s[\"%s: %s\"]\n", identifier(node), node.Label, mermaidString(node.Name))
+	}
+	for _, relationship := range graph.Relationships {
+		fmt.Fprintf(b, "\t%s -->|%s| %s\n", identifier(relationship.From), relationship.Type, identifier(relationship.To))
+	}
+}
+
+// Statements use MERGE, so running the script again updates the existing nodes rather than duplicating them
+func (graph *Graph) writeCypher(b *strings.Builder) {
+	for _, node := range graph.Nodes {
+		properties := []string{fmt.Sprintf("n.name = %s", cypherString(node.Name))}
+		keys := []string{}
+		for key := range node.Properties {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			properties = append(properties, fmt.Sprintf("n.%s = %s", key, cypherString(node.Properties[key])))
+		}
+		fmt.Fprintf(b, "MERGE (n:%s {id: %s}) SET %s;\n", node.Label, cypherString(node.Id), strings.Join(properties, ", "))
+	}
+	for _, relationship := range graph.Relationships {
+		fmt.Fprintf(b, "MATCH (a:%s {id: %s}), (b:%s {id: %s}) MERGE (a)-[:%s]->(b);\n",
+			relationship.From.Label, cypherString(relationship.From.Id), relationship.To.Label, cypherString(relationship.To.Id), relationship.Type)
+	}
+}
+
+// A unique identifier of the node made of letters, digits and underscores
+func identifier(node *Node) string {
+	return strings.ToLower(node.Label) + "_" + unsafeIdentifierCharacters.ReplaceAllString(node.Id, "_")
+}
+
+func dotString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+func mermaidString(value string) string {
+	return strings.ReplaceAll(value, `"`, "#quot;")
+}
+
+func cypherString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+type captureKey struct{}
+
+// Returns a context that keeps the values printed by a command executed with it, whatever the output format,
+// such as for batch to use them in the next steps. Only the result of the command is kept, which is the first values
+// it prints with PrintBodyMap, rather than the tables printed with PrintSecondaryBodyMap.
+func WithCapture(ctx context.Context, values *api.ResponseData) context.Context {
+	return context.WithValue(ctx, captureKey{}, values)
+}
+
+func capture(cmd *cobra.Command, values api.ResponseData) {
+	if cmd.Context() == nil {
+		return
+	}
+	if captured, ok := cmd.Context().Value(captureKey{}).(*api.ResponseData); ok && *captured == nil {
+		*captured = values
+	}
+}
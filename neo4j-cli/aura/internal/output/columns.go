@@ -0,0 +1,84 @@
+package output
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Renderers of rows that can leave out the header row
+type headerlessRenderer interface {
+	withoutHeaders() Renderer
+}
+
+// Adds the --columns, --no-headers, --quiet and --timezone flags to a command group, next to its --output flag
+func AddOutputFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("columns", "", "Comma separated fields to print as the columns of table, csv and tsv output, including nested fields such as metrics.integration. Defaults to the columns set with config set columns.<command>, or to the columns of the command")
+	cmd.PersistentFlags().Bool("no-headers", false, "Prints table, csv and tsv output without the header row")
+	cmd.PersistentFlags().BoolP("quiet", "q", false, "Prints only the IDs, one per line, whatever the output format")
+	cmd.PersistentFlags().Var(&Timezone{}, "timezone", `Time zone of the timestamps in table, csv and tsv output, such as "UTC" or "Europe/London". Tables written to a terminal show timestamps in the local time zone by default, with the time relative to now`)
+}
+
+// The columns given with --columns, or else the default columns of the command in the config
+func columnsOf(cmd *cobra.Command, cfg *clicfg.Config) []string {
+	if value, err := cmd.Flags().GetString("columns"); err == nil && value != "" {
+		columns := []string{}
+		for _, column := range strings.Split(value, ",") {
+			if column = strings.TrimSpace(column); column != "" {
+				columns = append(columns, column)
+			}
+		}
+		return columns
+	}
+	return cfg.Aura.Columns(commandKey(cmd))
+}
+
+// The name of a command in config keys, such as instance-list for aura instance list
+func commandKey(cmd *cobra.Command) string {
+	names := []string{}
+	for c := cmd; c != nil && c.HasParent() && c.Name() != "aura"; c = c.Parent() {
+		names = append([]string{c.Name()}, names...)
+	}
+	return strings.Join(names, "-")
+}
+
+func boolFlag(cmd *cobra.Command, name string) bool {
+	value, err := cmd.Flags().GetBool(name)
+	return err == nil && value
+}
+
+// Keeps the columns of every value, with nested fields as columns named by their path
+func selectColumns(values api.ResponseData, columns []string) api.ResponseData {
+	rows := []map[string]any{}
+	for _, value := range values.AsArray() {
+		row := map[string]any{}
+		for _, column := range columns {
+			row[column] = fieldValue(value, fieldPath(column))
+		}
+		rows = append(rows, row)
+	}
+	return api.NewResponseData(rows)
+}
+
+// The first field of a command that is an ID, such as id or snapshot_id
+func idField(fields []string) string {
+	if i := slices.IndexFunc(fields, func(field string) bool { return field == "id" || strings.HasSuffix(field, "_id") }); i >= 0 {
+		return fields[i]
+	}
+	return "id"
+}
+
+// Prints the ID of every value on its own line
+func printIds(cmd *cobra.Command, values api.ResponseData, fields []string) {
+	id := idField(fields)
+	for _, value := range values.AsArray() {
+		if id := cell(value[id]); id != "" {
+			fmt.Fprintln(cmd.OutOrStderr(), id)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+type DryRun struct {
+	Method string           `json:"method"`
+	Path   string           `json:"path"`
+	Body   map[string]any   `json:"body,omitempty"`
+	Diff   []map[string]any `json:"diff,omitempty"`
+}
+
+// Prints the request that would have been sent. If the current state of the resource is given, the fields that would be changed by the request are printed as well
+func PrintDryRun(cmd *cobra.Command, cfg *clicfg.Config, method string, path string, body map[string]any, current map[string]any) error {
+	dryRun := DryRun{
+		Method: method,
+		Path:   path,
+		Body:   body,
+	}
+	if current != nil {
+		dryRun.Diff = diff(current, body, "")
+	}
+
+	if IsStructured(cfg) {
+		return renderDocument(cmd.OutOrStderr(), cfg.Aura.Output(), dryRun)
+	}
+	// Other row based formats such as csv are parsed, so only the changes are printed, or the request when there is no current state
+	if !IsTable(cfg) {
+		if current != nil {
+			return PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(dryRun.Diff), []string{"field", "before", "after"})
+		}
+		return PrintSecondaryBodyMap(cmd, cfg, api.NewSingleValueResponseData(map[string]any{"method": method, "path": path, "body": body}), []string{"method", "path", "body"})
+	}
+
+	cmd.Println("Dry run, no request has been sent")
+	cmd.Println(method, path)
+	if len(body) > 0 {
+		bytes, err := json.MarshalIndent(body, "", "\t")
+		if err != nil {
+			panic(err)
+		}
+		cmd.Println(string(bytes))
+	}
+	if current != nil {
+		if len(dryRun.Diff) == 0 {
+			cmd.Println("No changes")
+		} else {
+			return PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(dryRun.Diff), []string{"field", "before", "after"})
+		}
+	}
+	return nil
+}
+
+// Compares the fields of a request body against the current resource, nested objects are compared field by field
+func diff(current map[string]any, body map[string]any, prefix string) []map[string]any {
+	keys := make([]string, 0, len(body))
+	for key := range body {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changes := []map[string]any{}
+	for _, key := range keys {
+		field := key
+		if prefix != "" {
+			field = fmt.Sprintf("%s.%s", prefix, key)
+		}
+
+		after := normalise(body[key])
+		before := current[key]
+
+		if nestedAfter, ok := after.(map[string]any); ok {
+			nestedBefore, _ := before.(map[string]any)
+			if nestedBefore == nil {
+				nestedBefore = map[string]any{}
+			}
+			changes = append(changes, diff(nestedBefore, nestedAfter, field)...)
+			continue
+		}
+
+		if !reflect.DeepEqual(before, after) {
+			changes = append(changes, map[string]any{
+				"field":  field,
+				"before": before,
+				"after":  after,
+			})
+		}
+	}
+
+	return changes
+}
+
+// Round trips a value through JSON so that it can be compared with a parsed response
+func normalise(value any) any {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	var normalised any
+	if err := json.Unmarshal(bytes, &normalised); err != nil {
+		panic(err)
+	}
+	return normalised
+}
@@ -0,0 +1,371 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+var jsonPathFilterPattern = regexp.MustCompile(`^\?\(@\.([A-Za-z0-9_.]+)\s*(==|!=)\s*(.+)\)$`)
+
+// A part of a JSONPath template: literal text, an expression to print, or a range over the values of an expression
+type jsonPathNode struct {
+	text       string
+	expression []jsonPathStep
+	// Nodes executed for every value of the expression, between {range ...} and {end}
+	rangeNodes []jsonPathNode
+	isRange    bool
+}
+
+// A step of a JSONPath expression, selecting values from the values of the previous step
+type jsonPathStep func(values []any) []any
+
+// Prints the values selected by a JSONPath template, like kubectl -o jsonpath. The template is applied to
+// the whole JSON document, such as {.data[*].id}. Supported expressions are fields (.name or ['name']),
+// indexes ([0], [-1]), slices ([1:3]), wildcards ([*] or .*), recursive descent (..name) and
+// filters ([?(@.status=="running")]). {range .data[*]}...{end} repeats its content for every value,
+// where expressions are relative to the value, and {"\n"} prints a string.
+type jsonPathRenderer struct {
+	nodes []jsonPathNode
+}
+
+func newJsonPathRenderer(text string) (Renderer, error) {
+	nodes, rest, err := parseJsonPath(text, false)
+	if err != nil {
+		return nil, clierr.NewUsageError("invalid output JSONPath %s: %w", text, err)
+	}
+	if rest != "" {
+		return nil, clierr.NewUsageError("invalid output JSONPath %s: {end} without {range}", text)
+	}
+	return jsonPathRenderer{nodes: nodes}, nil
+}
+
+func (renderer jsonPathRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	document, err := asDocument(values)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	executeJsonPath(&b, renderer.nodes, document)
+	_, err = fmt.Fprintln(w, b.String())
+	return err
+}
+
+func (jsonPathRenderer) Structured() bool {
+	return true
+}
+
+// Parses nodes until the end of the text or, within a range, until {end}. Returns the text after {end}.
+func parseJsonPath(text string, inRange bool) ([]jsonPathNode, string, error) {
+	nodes := []jsonPathNode{}
+	for text != "" {
+		start := strings.Index(text, "{")
+		if start < 0 {
+			nodes = append(nodes, jsonPathNode{text: text})
+			break
+		}
+		if start > 0 {
+			nodes = append(nodes, jsonPathNode{text: text[:start]})
+		}
+		end := strings.Index(text[start:], "}")
+		if end < 0 {
+			return nil, "", fmt.Errorf("unclosed {")
+		}
+		action := strings.TrimSpace(text[start+1 : start+end])
+		text = text[start+end+1:]
+
+		switch {
+		case action == "end":
+			if !inRange {
+				return nodes, "{end}" + text, nil
+			}
+			return nodes, text, nil
+		case strings.HasPrefix(action, "range "):
+			expression, err := parseJsonPathExpression(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, "", err
+			}
+			rangeNodes, rest, err := parseJsonPath(text, true)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{expression: expression, rangeNodes: rangeNodes, isRange: true})
+			text = rest
+			continue
+		case strings.HasPrefix(action, `"`):
+			literal, err := strconv.Unquote(action)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid string %s", action)
+			}
+			nodes = append(nodes, jsonPathNode{text: literal})
+		default:
+			expression, err := parseJsonPathExpression(action)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{expression: expression})
+		}
+	}
+	if inRange {
+		return nil, "", fmt.Errorf("{range} without {end}")
+	}
+	return nodes, "", nil
+}
+
+func parseJsonPathExpression(expression string) ([]jsonPathStep, error) {
+	steps := []jsonPathStep{}
+	rest := strings.TrimPrefix(expression, "$")
+	if rest == "" || rest[0] != '.' && rest[0] != '[' {
+		return nil, fmt.Errorf("expression %s must start with . or [", expression)
+	}
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			name, after := cutName(rest[2:])
+			if name == "" {
+				return nil, fmt.Errorf("missing field name after .. in %s", expression)
+			}
+			steps = append(steps, recursiveStep(name))
+			rest = after
+		case strings.HasPrefix(rest, ".*"):
+			steps = append(steps, wildcardStep)
+			rest = rest[2:]
+		case rest[0] == '.':
+			name, after := cutName(rest[1:])
+			if name != "" {
+				steps = append(steps, fieldStep(name))
+			}
+			rest = after
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ in %s", expression)
+			}
+			step, err := parseBracketStep(rest[1:end])
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %s in %s", rest, expression)
+		}
+	}
+	return steps, nil
+}
+
+func cutName(text string) (string, string) {
+	end := strings.IndexAny(text, ".[")
+	if end < 0 {
+		return text, ""
+	}
+	return text[:end], text[end:]
+}
+
+func parseBracketStep(selector string) (jsonPathStep, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "*" {
+		return wildcardStep, nil
+	}
+	if unquoted, err := strconv.Unquote(strings.ReplaceAll(selector, "'", `"`)); err == nil {
+		return fieldStep(unquoted), nil
+	}
+	if matches := jsonPathFilterPattern.FindStringSubmatch(selector); matches != nil {
+		return filterStep(strings.Split(matches[1], "."), matches[2] == "==", parseFilterValue(matches[3])), nil
+	}
+	if from, to, isSlice := strings.Cut(selector, ":"); isSlice {
+		return sliceStep(from, to)
+	}
+	index, err := strconv.Atoi(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector [%s]", selector)
+	}
+	return indexStep(index), nil
+}
+
+func parseFilterValue(text string) any {
+	text = strings.TrimSpace(text)
+	if unquoted, err := strconv.Unquote(strings.ReplaceAll(text, "'", `"`)); err == nil {
+		return unquoted
+	}
+	if number, err := strconv.ParseFloat(text, 64); err == nil {
+		return number
+	}
+	if text == "true" || text == "false" {
+		return text == "true"
+	}
+	return text
+}
+
+func fieldStep(name string) jsonPathStep {
+	return func(values []any) []any {
+		selected := []any{}
+		for _, value := range values {
+			if object, ok := value.(map[string]any); ok {
+				if field, ok := object[name]; ok {
+					selected = append(selected, field)
+				}
+			}
+		}
+		return selected
+	}
+}
+
+func wildcardStep(values []any) []any {
+	selected := []any{}
+	for _, value := range values {
+		switch value := value.(type) {
+		case []any:
+			selected = append(selected, value...)
+		case map[string]any:
+			for _, key := range sortedKeys(value) {
+				selected = append(selected, value[key])
+			}
+		}
+	}
+	return selected
+}
+
+func indexStep(index int) jsonPathStep {
+	return func(values []any) []any {
+		selected := []any{}
+		for _, value := range values {
+			if list, ok := value.([]any); ok {
+				i := index
+				if i < 0 {
+					i += len(list)
+				}
+				if i >= 0 && i < len(list) {
+					selected = append(selected, list[i])
+				}
+			}
+		}
+		return selected
+	}
+}
+
+func sliceStep(fromText string, toText string) (jsonPathStep, error) {
+	bound := func(text string, fallback int, length int) (int, error) {
+		if strings.TrimSpace(text) == "" {
+			return fallback, nil
+		}
+		i, err := strconv.Atoi(strings.TrimSpace(text))
+		if err != nil {
+			return 0, fmt.Errorf("invalid slice bound %s", text)
+		}
+		if i < 0 {
+			i += length
+		}
+		return max(0, min(i, length)), nil
+	}
+	if _, err := bound(fromText, 0, 0); err != nil {
+		return nil, err
+	}
+	if _, err := bound(toText, 0, 0); err != nil {
+		return nil, err
+	}
+	return func(values []any) []any {
+		selected := []any{}
+		for _, value := range values {
+			if list, ok := value.([]any); ok {
+				from, _ := bound(fromText, 0, len(list))
+				to, _ := bound(toText, len(list), len(list))
+				if from < to {
+					selected = append(selected, list[from:to]...)
+				}
+			}
+		}
+		return selected
+	}, nil
+}
+
+func filterStep(path []string, equal bool, expected any) jsonPathStep {
+	return func(values []any) []any {
+		selected := []any{}
+		for _, item := range wildcardStep(values) {
+			actual := []any{item}
+			for _, name := range path {
+				actual = fieldStep(name)(actual)
+			}
+			matches := len(actual) == 1 && fmt.Sprint(normaliseNumber(actual[0])) == fmt.Sprint(expected)
+			if matches == equal {
+				selected = append(selected, item)
+			}
+		}
+		return selected
+	}
+}
+
+func recursiveStep(name string) jsonPathStep {
+	var descend func(value any, selected []any) []any
+	descend = func(value any, selected []any) []any {
+		switch value := value.(type) {
+		case map[string]any:
+			if field, ok := value[name]; ok {
+				selected = append(selected, field)
+			}
+			for _, key := range sortedKeys(value) {
+				selected = descend(value[key], selected)
+			}
+		case []any:
+			for _, item := range value {
+				selected = descend(item, selected)
+			}
+		}
+		return selected
+	}
+	return func(values []any) []any {
+		selected := []any{}
+		for _, value := range values {
+			selected = descend(value, selected)
+		}
+		return selected
+	}
+}
+
+// Integers decoded from JSON compare with the float values of filters
+func normaliseNumber(value any) any {
+	if i, ok := value.(int64); ok {
+		return float64(i)
+	}
+	return value
+}
+
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func executeJsonPath(b *strings.Builder, nodes []jsonPathNode, current any) {
+	for _, node := range nodes {
+		if node.expression == nil {
+			b.WriteString(node.text)
+			continue
+		}
+		values := []any{current}
+		for _, step := range node.expression {
+			values = step(values)
+		}
+		if node.isRange {
+			for _, value := range values {
+				executeJsonPath(b, node.rangeNodes, value)
+			}
+			continue
+		}
+		cells := []string{}
+		for _, value := range values {
+			cells = append(cells, cell(value))
+		}
+		b.WriteString(strings.Join(cells, " "))
+	}
+}
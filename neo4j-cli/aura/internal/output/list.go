@@ -0,0 +1,255 @@
+package output
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Operators of filter conditions, longest first so that != is not read as =
+var filterOperators = []string{"!=", "!~", ">=", "<=", "=", "~", ">", "<"}
+
+// The client-side filter and sort of a list command, applied to the list before it is rendered
+type ListOptions struct {
+	Filter Filter
+	Sort   Sort
+}
+
+// Adds the --filter and --sort flags of list commands
+func AddListFlags(cmd *cobra.Command, options *ListOptions) {
+	cmd.Flags().Var(&options.Filter, "filter", `An optional comma separated list of conditions that every listed value must match, such as "status=running,type~enterprise*". Conditions compare a field, or a nested field such as "a.b", with = and != for equality, ~ and !~ for glob patterns with * and ?, and >, >=, < and <= for ordering. Comparisons ignore case.`)
+	cmd.Flags().Var(&options.Sort, "sort", `An optional comma separated list of fields to sort the list by, such as "name,-created". Fields prefixed with - are sorted in descending order.`)
+}
+
+// Keeps the values that match the filter, in the order of the sort. Values that are not a list are returned as they are.
+func (options *ListOptions) Apply(values api.ResponseData) api.ResponseData {
+	list, ok := values.(api.ListResponseData)
+	if !ok {
+		return values
+	}
+	rows := []map[string]any{}
+	for _, row := range list.Data {
+		if options.Filter.Matches(row) {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortStableFunc(rows, options.Sort.Compare)
+	return api.NewResponseData(rows)
+}
+
+type filterCondition struct {
+	path     []string
+	operator string
+	value    string
+	pattern  *regexp.Regexp
+}
+
+type Filter struct {
+	text       string
+	conditions []filterCondition
+}
+
+// String is used both by fmt.Print and by Cobra in help text
+func (f *Filter) String() string {
+	return f.text
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (f *Filter) Set(v string) error {
+	conditions := []filterCondition{}
+	for _, text := range strings.Split(v, ",") {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		condition, err := parseFilterCondition(text)
+		if err != nil {
+			return err
+		}
+		conditions = append(conditions, condition)
+	}
+	f.text = v
+	f.conditions = conditions
+	return nil
+}
+
+// Type is only used in help text
+func (f *Filter) Type() string {
+	return "conditions"
+}
+
+// Whether the value matches every condition of the filter
+func (f *Filter) Matches(row map[string]any) bool {
+	for _, condition := range f.conditions {
+		if !condition.matches(row) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseFilterCondition(text string) (filterCondition, error) {
+	start := strings.IndexAny(text, "!=~<>")
+	if start <= 0 {
+		return filterCondition{}, fmt.Errorf("condition %q must be a field, one of the operators %s, and a value", strings.TrimSpace(text), strings.Join(filterOperators, " "))
+	}
+	for _, operator := range filterOperators {
+		if !strings.HasPrefix(text[start:], operator) {
+			continue
+		}
+		condition := filterCondition{
+			path:     fieldPath(text[:start]),
+			operator: operator,
+			value:    strings.TrimSpace(text[start+len(operator):]),
+		}
+		if operator == "~" || operator == "!~" {
+			condition.pattern = globPattern(condition.value)
+		}
+		return condition, nil
+	}
+	return filterCondition{}, fmt.Errorf("condition %q has an unknown operator, must be one of %s", strings.TrimSpace(text), strings.Join(filterOperators, " "))
+}
+
+// A case insensitive regular expression for a glob pattern, where * matches any text and ? any character
+func globPattern(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+func (condition filterCondition) matches(row map[string]any) bool {
+	actual := cell(fieldValue(row, condition.path))
+	switch condition.operator {
+	case "=":
+		return strings.EqualFold(actual, condition.value)
+	case "!=":
+		return !strings.EqualFold(actual, condition.value)
+	case "~":
+		return condition.pattern.MatchString(actual)
+	case "!~":
+		return !condition.pattern.MatchString(actual)
+	}
+	if actual == "" {
+		return false
+	}
+	comparison := compareCells(actual, condition.value)
+	switch condition.operator {
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	default:
+		return comparison <= 0
+	}
+}
+
+type sortKey struct {
+	path       []string
+	descending bool
+}
+
+type Sort struct {
+	text string
+	keys []sortKey
+}
+
+// String is used both by fmt.Print and by Cobra in help text
+func (s *Sort) String() string {
+	return s.text
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (s *Sort) Set(v string) error {
+	keys := []sortKey{}
+	for _, field := range strings.Split(v, ",") {
+		field = strings.TrimSpace(field)
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		if field == "" {
+			return errors.New(`must be a comma separated list of fields, such as "name,-created"`)
+		}
+		keys = append(keys, sortKey{path: fieldPath(field), descending: descending})
+	}
+	s.text = v
+	s.keys = keys
+	return nil
+}
+
+// Type is only used in help text
+func (s *Sort) Type() string {
+	return "fields"
+}
+
+// Compares values by the first field of the sort they differ in. Values without the field are sorted last.
+func (s *Sort) Compare(a map[string]any, b map[string]any) int {
+	for _, key := range s.keys {
+		valueA := cell(fieldValue(a, key.path))
+		valueB := cell(fieldValue(b, key.path))
+		if valueA == "" || valueB == "" {
+			if comparison := cmp.Compare(valueB, valueA); comparison != 0 {
+				return comparison
+			}
+			continue
+		}
+		comparison := compareCells(valueA, valueB)
+		if key.descending {
+			comparison = -comparison
+		}
+		if comparison != 0 {
+			return comparison
+		}
+	}
+	return 0
+}
+
+func fieldPath(field string) []string {
+	return strings.Split(strings.TrimSpace(field), ".")
+}
+
+// The value of a field, or of a nested field of objects, nil if it is missing
+func fieldValue(row map[string]any, path []string) any {
+	var value any = row
+	for _, name := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// Compares numbers and memory sizes by their value, and other values as text ignoring case, which orders timestamps by time
+func compareCells(a string, b string) int {
+	if numberA, err := strconv.ParseFloat(a, 64); err == nil {
+		if numberB, err := strconv.ParseFloat(b, 64); err == nil {
+			return cmp.Compare(numberA, numberB)
+		}
+	}
+	if sizeA, ok := api.MemoryInGB(a); ok {
+		if sizeB, ok := api.MemoryInGB(b); ok {
+			return cmp.Compare(sizeA, sizeB)
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
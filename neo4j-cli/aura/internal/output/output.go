@@ -1,71 +1,87 @@
 package output
 
 import (
-	"encoding/json"
-	"reflect"
+	"time"
 
-	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 )
 
-func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string) {
-	outputType := cfg.Aura.Output()
-
-	switch output := outputType; output {
-	case "json":
-		bytes, err := json.MarshalIndent(values, "", "\t")
-		if err != nil {
-			panic(err)
-		}
-		cmd.Println(string(bytes))
-	case "table", "default":
-		printTable(cmd, values, fields)
-	default:
-		// This is in case the value is unknown
-		cmd.Println(values)
+// Prints the values in the output format, as the result of the command. Fails if they cannot be rendered, such as with a template that fails to execute.
+func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string) error {
+	capture(cmd, values)
+	var changed []bool
+	if state := watchStateOf(cmd); state != nil {
+		state.record(values, fields)
+		changed = state.changed
+	}
+	if boolFlag(cmd, "quiet") {
+		printIds(cmd, values, fields)
+		return nil
 	}
+	if columns := columnsOf(cmd, cfg); len(columns) > 0 && !IsStructured(cfg) {
+		values = selectColumns(values, columns)
+		fields = columns
+	}
+	return render(cmd, cfg, values, fields, changed)
 }
 
-func PrintBody(cmd *cobra.Command, cfg *clicfg.Config, body []byte, fields []string) {
-	if len(body) == 0 {
-		return
+// Prints values that come with the result of the command, such as the instance configurations of tenant get.
+// They keep their own columns whatever --columns is set to, and are left out with --quiet.
+func PrintSecondaryBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string) error {
+	if boolFlag(cmd, "quiet") {
+		return nil
 	}
-	values := api.ParseBody(body)
-
-	PrintBodyMap(cmd, cfg, values, fields)
+	return render(cmd, cfg, values, fields, nil)
 }
 
-func printTable(cmd *cobra.Command, responseData api.ResponseData, fields []string) {
-	t := table.NewWriter()
-
-	header := table.Row{}
-	for _, f := range fields {
-		header = append(header, f)
+// changed marks the rows whose status changed since the previous refresh of --watch, which is nil unless watching
+func render(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string, changed []bool) error {
+	renderer, err := rendererFor(cfg.Aura.Output())
+	if err != nil {
+		return err
 	}
-
-	t.AppendHeader(header)
-	for _, v := range responseData.AsArray() {
-		row := table.Row{}
-		for _, f := range fields {
-			formattedValue := v[f]
-
-			if v[f] == nil {
-				formattedValue = ""
+	if !renderer.Structured() {
+		// Tables written to a terminal are read by people, who prefer relative times
+		_, isTable := renderer.(tableRenderer)
+		relative := isTable && IsTerminal(cmd.OutOrStderr())
+		if location := timezoneOf(cmd); location != nil || relative {
+			if location == nil {
+				location = time.Local
 			}
+			values = formatTimes(values, fields, location, relative, time.Now())
+		}
+		if headerless, ok := renderer.(headerlessRenderer); ok && boolFlag(cmd, "no-headers") {
+			renderer = headerless.withoutHeaders()
+		}
+	}
+	if table, ok := renderer.(tableRenderer); ok {
+		table.changed = changed
+		renderer = table
+	}
+	return renderer.Render(cmd.OutOrStderr(), values, fields)
+}
 
-			if reflect.TypeOf(formattedValue).Kind() == reflect.Slice {
-				marshaledSlice, _ := json.MarshalIndent(formattedValue, "", "  ")
-				formattedValue = string(marshaledSlice)
-			}
+// Whether the output renders whole documents, such as JSON, rather than rows of fields such as tables
+func IsStructured(cfg *clicfg.Config) bool {
+	renderer, err := rendererFor(cfg.Aura.Output())
+	return err == nil && renderer.Structured()
+}
 
-			row = append(row, formattedValue)
-		}
-		t.AppendRow(row)
+// Whether the output is a table, which is read by people, so can be followed by other tables or text
+func IsTable(cfg *clicfg.Config) bool {
+	renderer, err := rendererFor(cfg.Aura.Output())
+	_, isTable := renderer.(tableRenderer)
+	return err == nil && isTable
+}
+
+func PrintBody(cmd *cobra.Command, cfg *clicfg.Config, body []byte, fields []string) error {
+	if len(body) == 0 {
+		return nil
 	}
+	values := api.ParseBody(body)
 
-	t.SetStyle(table.StyleLight)
-	cmd.Println(t.Render())
+	return PrintBodyMap(cmd, cfg, values, fields)
 }
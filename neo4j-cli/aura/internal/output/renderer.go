@@ -0,0 +1,419 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"gopkg.in/yaml.v3"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Renders response data in an output format. Fields are the columns of row based formats,
+// formats that render whole documents ignore them.
+type Renderer interface {
+	Render(w io.Writer, values api.ResponseData, fields []string) error
+	// Whether the format renders whole documents, rather than rows of fields
+	Structured() bool
+}
+
+var renderers = map[string]Renderer{
+	"default": tableRenderer{},
+	"table":   tableRenderer{},
+	"json":    jsonRenderer{},
+	"yaml":    yamlRenderer{},
+	"csv":     delimitedRenderer{separator: ','},
+	"tsv":     delimitedRenderer{separator: '\t'},
+	"ndjson":  ndjsonRenderer{},
+}
+
+// Renderers that take an argument, given as the output value <name>=<argument> such as template={{.id}}
+var rendererFactories = map[string]func(argument string) (Renderer, error){
+	"template": newTemplateRenderer,
+	"jsonpath": newJsonPathRenderer,
+}
+
+// Fails if there is no renderer for the output value, or if its argument is invalid. An empty value uses the configured output.
+func ValidateOutput(value string) error {
+	if value == "" {
+		return nil
+	}
+	_, err := rendererFor(value)
+	return err
+}
+
+func rendererFor(value string) (Renderer, error) {
+	if renderer, ok := renderers[value]; ok {
+		return renderer, nil
+	}
+	if name, argument, ok := strings.Cut(value, "="); ok {
+		if factory, ok := rendererFactories[name]; ok {
+			return factory(argument)
+		}
+	}
+	return nil, clierr.NewUsageError("invalid output value specified: %s", value)
+}
+
+// Colours of status values in tables written to terminals
+var statusColors = map[string]text.Colors{
+	"running": {text.FgGreen},
+	"ready":   {text.FgGreen},
+	"paused":  {text.FgHiBlack},
+	"failed":  {text.FgRed},
+}
+
+// The narrowest width columns are shrunk to when fitting a table to the terminal
+const minColumnWidth = 8
+
+// Marks the status of a row that changed since the previous refresh of --watch
+const changedMarker = "*"
+
+type tableRenderer struct {
+	noHeaders bool
+	// Whether the status of each row changed since the previous refresh of --watch
+	changed []bool
+}
+
+func (renderer tableRenderer) Render(w io.Writer, responseData api.ResponseData, fields []string) error {
+	t := table.NewWriter()
+
+	header := table.Row{}
+	for _, f := range fields {
+		header = append(header, f)
+	}
+
+	if !renderer.noHeaders {
+		t.AppendHeader(header)
+	}
+	colors := colorEnabled(w)
+	rows := []table.Row{}
+	for i, v := range responseData.AsArray() {
+		row := table.Row{}
+		for _, f := range fields {
+			formattedValue := tableCell(v[f])
+			if status, ok := formattedValue.(string); ok && f == "status" {
+				if statusColor, ok := statusColors[strings.ToLower(status)]; ok && colors {
+					formattedValue = statusColor.Sprint(status)
+				}
+				if i < len(renderer.changed) && renderer.changed[i] {
+					formattedValue = fmt.Sprintf("%s %s", formattedValue, changedMarker)
+				}
+			}
+			row = append(row, formattedValue)
+		}
+		rows = append(rows, row)
+	}
+	t.AppendRows(rows)
+
+	t.SetStyle(table.StyleLight)
+	if width := terminalWidth(w); width > 0 {
+		t.SetColumnConfigs(fitColumns(header, rows, width))
+	}
+	_, err := fmt.Fprintln(w, t.Render())
+	return err
+}
+
+// Lists of values are joined with commas, and lists of objects have an object per line.
+// Objects are written as key=value pairs, which can be wrapped to fit the terminal.
+func tableCell(value any) any {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case map[string]any:
+		pairs := []string{}
+		for _, key := range sortedKeys(value) {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", key, cell(value[key])))
+		}
+		return strings.Join(pairs, " ")
+	case []any:
+		items := []string{}
+		separator := ", "
+		for _, item := range value {
+			if _, isObject := item.(map[string]any); isObject {
+				separator = "\n"
+			}
+			items = append(items, fmt.Sprint(tableCell(item)))
+		}
+		return strings.Join(items, separator)
+	default:
+		return value
+	}
+}
+
+// Narrows the widest columns until the table fits the width, wrapping or truncating their values
+func fitColumns(header table.Row, rows []table.Row, width int) []table.ColumnConfig {
+	widths := make([]int, len(header))
+	for _, row := range append([]table.Row{header}, rows...) {
+		for i, value := range row {
+			widths[i] = max(widths[i], text.LongestLineLen(fmt.Sprint(value)))
+		}
+	}
+	// Every column has a border and a space of padding on each side
+	available := width - 3*len(widths) - 1
+	fitted := slices.Clone(widths)
+	for sum(fitted) > available {
+		widest := 0
+		for i := range fitted {
+			if fitted[i] > fitted[widest] {
+				widest = i
+			}
+		}
+		if fitted[widest] <= minColumnWidth {
+			break
+		}
+		fitted[widest]--
+	}
+
+	configs := []table.ColumnConfig{}
+	for i := range widths {
+		if fitted[i] < widths[i] {
+			configs = append(configs, table.ColumnConfig{Number: i + 1, WidthMax: fitted[i], WidthMaxEnforcer: fitValue})
+		}
+	}
+	return configs
+}
+
+// Wraps text at spaces, and truncates values without spaces such as IDs and URLs
+func fitValue(value string, width int) string {
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		if strings.Contains(strings.TrimSpace(line), " ") {
+			lines[i] = text.WrapSoft(line, width)
+		} else {
+			lines[i] = text.Snip(line, width, "…")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
+
+func (tableRenderer) Structured() bool {
+	return false
+}
+
+func (tableRenderer) withoutHeaders() Renderer {
+	return tableRenderer{noHeaders: true}
+}
+
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	return renderDocument(w, "json", values)
+}
+
+func (jsonRenderer) Structured() bool {
+	return true
+}
+
+type yamlRenderer struct{}
+
+// Renders the same document as JSON output, with keys in alphabetical order
+func (yamlRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	return renderYaml(w, values)
+}
+
+func (yamlRenderer) Structured() bool {
+	return true
+}
+
+// Renders a line per value with a compact JSON object, without the data wrapper
+type ndjsonRenderer struct{}
+
+func (ndjsonRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	for _, value := range values.AsArray() {
+		line, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, string(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ndjsonRenderer) Structured() bool {
+	return true
+}
+
+// Renders a header row of fields then a row per value. Lists and objects are rendered as compact JSON.
+// Values are quoted as needed with commas, while tabs and newlines are escaped with backslashes so that each row stays on one line.
+type delimitedRenderer struct {
+	separator rune
+	noHeaders bool
+}
+
+func (renderer delimitedRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	rows := values.AsArray()
+	if len(fields) == 0 {
+		fields = keys(rows)
+	}
+	records := [][]string{}
+	if !renderer.noHeaders {
+		records = append(records, fields)
+	}
+	for _, row := range rows {
+		record := []string{}
+		for _, field := range fields {
+			record = append(record, cell(row[field]))
+		}
+		records = append(records, record)
+	}
+
+	if renderer.separator != '\t' {
+		writer := csv.NewWriter(w)
+		writer.Comma = renderer.separator
+		return writer.WriteAll(records)
+	}
+
+	escaper := strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+	for _, record := range records {
+		for i := range record {
+			record[i] = escaper.Replace(record[i])
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(record, "\t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (delimitedRenderer) Structured() bool {
+	return false
+}
+
+func (renderer delimitedRenderer) withoutHeaders() Renderer {
+	return delimitedRenderer{separator: renderer.separator, noHeaders: true}
+}
+
+// Renders a document that is not response data, such as a dry run, in a structured output format
+func renderDocument(w io.Writer, format string, document any) error {
+	switch format {
+	case "json":
+		bytes, err := json.MarshalIndent(document, "", "\t")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bytes))
+		return err
+	case "yaml":
+		return renderYaml(w, document)
+	case "ndjson":
+		line, err := json.Marshal(document)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(line))
+		return err
+	}
+
+	// Other renderers take the document as a single value
+	renderer, err := rendererFor(format)
+	if err != nil {
+		return err
+	}
+	value, err := asDocument(document)
+	if err != nil {
+		return err
+	}
+	object, _ := value.(map[string]any)
+	return renderer.Render(w, api.NewSingleValueResponseData(object), nil)
+}
+
+func renderYaml(w io.Writer, values any) error {
+	document, err := asDocument(values)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// The values as generic JSON, so that they are rendered with their JSON field names
+func asDocument(values any) (any, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return numbers(document), nil
+}
+
+// Replaces JSON numbers with integers or floats, so that YAML renders them as numbers
+func numbers(value any) any {
+	switch value := value.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]any:
+		for key, v := range value {
+			value[key] = numbers(v)
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = numbers(v)
+		}
+	}
+	return value
+}
+
+// The keys of every row in alphabetical order
+func keys(rows []map[string]any) []string {
+	keys := []string{}
+	for _, row := range rows {
+		for key := range row {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func cell(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool, int, int64:
+		return fmt.Sprint(value)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Functions available to templates, in addition to the text/template builtins
+var templateFuncs = template.FuncMap{
+	// Joins the values of a list with a separator, such as {{join .tags ","}}
+	"join": func(values any, separator string) string {
+		list, ok := values.([]any)
+		if !ok {
+			return cell(values)
+		}
+		items := []string{}
+		for _, value := range list {
+			items = append(items, cell(value))
+		}
+		return strings.Join(items, separator)
+	},
+	"upper": func(value any) string {
+		return strings.ToUpper(cell(value))
+	},
+	"lower": func(value any) string {
+		return strings.ToLower(cell(value))
+	},
+	// Returns the fallback when the value is missing or empty, such as {{default "none" .customer_managed_key_id}}
+	"default": func(fallback any, value any) any {
+		if value == nil || value == "" {
+			return fallback
+		}
+		return value
+	},
+	// Formats an RFC 3339 timestamp with a Go layout, such as {{date "2006-01-02" .created}}. Other values are returned as they are.
+	"date": func(layout string, value any) string {
+		timestamp, err := time.Parse(time.RFC3339, cell(value))
+		if err != nil {
+			return cell(value)
+		}
+		return timestamp.Format(layout)
+	},
+	"json": func(value any) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+// Executes a Go template for every value, printing a line for each, like docker --format
+type templateRenderer struct {
+	template *template.Template
+}
+
+func newTemplateRenderer(text string) (Renderer, error) {
+	t, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, clierr.NewUsageError("invalid output template: %w", err)
+	}
+	return templateRenderer{template: t}, nil
+}
+
+func (renderer templateRenderer) Render(w io.Writer, values api.ResponseData, fields []string) error {
+	for _, value := range values.AsArray() {
+		var b strings.Builder
+		if err := renderer.template.Execute(&b, value); err != nil {
+			return clierr.NewUsageError("cannot execute output template: %w", err)
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimSuffix(b.String(), "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (templateRenderer) Structured() bool {
+	return true
+}
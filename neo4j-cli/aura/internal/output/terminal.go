@@ -0,0 +1,36 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"golang.org/x/term"
+)
+
+// Whether the stream, such as the input or the output of a command, is an interactive terminal rather than a pipe or a file
+func IsTerminal(stream any) bool {
+	file, ok := stream.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// The width of the terminal that output is written to, from the COLUMNS environment variable if it is set.
+// Returns 0 when the output is not a terminal, such as a pipe or a file, so that output is not fitted to a width.
+func terminalWidth(w io.Writer) int {
+	if !IsTerminal(w) {
+		return 0
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	width, _, err := term.GetSize(int(w.(*os.File).Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// Colours are only written to terminals, and never when NO_COLOR is set, see https://no-color.org
+func colorEnabled(w io.Writer) bool {
+	return IsTerminal(w) && os.Getenv("NO_COLOR") == ""
+}
@@ -0,0 +1,118 @@
+package output
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	// Time zones are available on systems without a time zone database, such as Windows
+	_ "time/tzdata"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Fields with timestamps, such as the timestamp of snapshots and the creation time of customer managed keys
+var timeFields = []string{"timestamp", "created"}
+
+type Timezone struct {
+	location *time.Location
+}
+
+// String is used both by fmt.Print and by Cobra in help text
+func (tz *Timezone) String() string {
+	if tz.location == nil {
+		return ""
+	}
+	return tz.location.String()
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (tz *Timezone) Set(v string) error {
+	location, err := time.LoadLocation(v)
+	if err != nil {
+		return fmt.Errorf(`must be "Local", "UTC" or a time zone name such as "Europe/London"`)
+	}
+	tz.location = location
+	return nil
+}
+
+// Type is only used in help text
+func (tz *Timezone) Type() string {
+	return "timezone"
+}
+
+// The time zone given with --timezone, nil if it is not given
+func timezoneOf(cmd *cobra.Command) *time.Location {
+	flag := cmd.Flags().Lookup("timezone")
+	if flag == nil {
+		return nil
+	}
+	timezone, _ := flag.Value.(*Timezone)
+	if timezone == nil {
+		return nil
+	}
+	return timezone.location
+}
+
+// Formats the timestamps of the fields in a time zone. Relative timestamps are formatted for people,
+// such as 2024-09-12 15:51 CEST (3 hours ago), other timestamps keep the RFC 3339 format of the API.
+func formatTimes(values api.ResponseData, fields []string, location *time.Location, relative bool, now time.Time) api.ResponseData {
+	rows := []map[string]any{}
+	for _, value := range values.AsArray() {
+		row := map[string]any{}
+		for key, v := range value {
+			row[key] = v
+		}
+		for _, field := range fields {
+			if !slices.Contains(timeFields, field[strings.LastIndex(field, ".")+1:]) {
+				continue
+			}
+			text, _ := row[field].(string)
+			timestamp, err := time.Parse(time.RFC3339, text)
+			if err != nil {
+				continue
+			}
+			if relative {
+				row[field] = fmt.Sprintf("%s (%s)", timestamp.In(location).Format("2006-01-02 15:04 MST"), relativeTime(timestamp, now))
+			} else {
+				row[field] = timestamp.In(location).Format(time.RFC3339)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return api.NewResponseData(rows)
+}
+
+// The time from now in the largest unit, such as 3 hours ago or in 2 days
+func relativeTime(timestamp time.Time, now time.Time) string {
+	difference := now.Sub(timestamp)
+	format := "%d %s ago"
+	if difference < 0 {
+		difference = -difference
+		format = "in %d %s"
+	}
+	if difference < time.Minute {
+		return "just now"
+	}
+	for _, unit := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	} {
+		if count := int(difference / unit.duration); count >= 1 {
+			name := unit.name
+			if count > 1 {
+				name += "s"
+			}
+			return fmt.Sprintf(format, count, name)
+		}
+	}
+	return "just now"
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Moves the cursor to the top left and clears the terminal, so that every refresh is drawn in place
+const clearScreen = "\033[H\033[2J"
+
+type watchKey struct{}
+
+// The values printed by the refreshes of a watched command
+type watchState struct {
+	// The values printed by the last refresh
+	values []map[string]any
+	// The statuses printed by the last refresh, by ID
+	statuses map[string]string
+	// Whether the status of each value of the last refresh changed since the refresh before
+	changed []bool
+}
+
+// Adds --watch and --until to a get or list command. With --watch, the command is run again at every interval,
+// and statuses that changed since the previous refresh are marked in tables.
+// With --until, watching stops once values are printed and every one of them matches the condition.
+func AddWatchFlags(cmd *cobra.Command) {
+	var until Filter
+
+	cmd.Flags().Duration("watch", 0, "Runs the command again at every interval until interrupted, such as --watch=10s, redrawing the output in place on terminals. Defaults to 5s when no interval is given")
+	cmd.Flags().Lookup("watch").NoOptDefVal = "5s"
+	cmd.Flags().Var(&until, "until", `An optional condition that stops watching once values are printed and every one of them matches it, such as "status=running". Uses the same conditions as --filter`)
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		interval, _ := cmd.Flags().GetDuration("watch")
+		if interval <= 0 {
+			if cmd.Flags().Changed("until") {
+				return clierr.NewUsageError("--until can only be used with --watch")
+			}
+			return run(cmd, args)
+		}
+
+		// Interrupting stops watching rather than the process, so that the last refresh stays on screen
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+		state := &watchState{statuses: map[string]string{}}
+		cmd.SetContext(context.WithValue(ctx, watchKey{}, state))
+		w := cmd.OutOrStderr()
+		for refresh := 0; ; refresh++ {
+			if IsTerminal(w) {
+				fmt.Fprint(w, clearScreen)
+			} else if refresh > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "Every %s: %s\n", interval, strings.Join(append([]string{cmd.CommandPath()}, args...), " "))
+			state.values = nil
+			if err := run(cmd, args); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("until") && state.holds(&until) {
+				return nil
+			}
+			select {
+			case <-cmd.Context().Done():
+				return nil
+			case <-time.After(interval):
+			}
+		}
+	}
+}
+
+// Whether the command is run by --watch, such as for a list to fetch the statuses it watches
+func IsWatching(cmd *cobra.Command) bool {
+	return watchStateOf(cmd) != nil
+}
+
+func watchStateOf(cmd *cobra.Command) *watchState {
+	if cmd.Context() == nil {
+		return nil
+	}
+	state, _ := cmd.Context().Value(watchKey{}).(*watchState)
+	return state
+}
+
+// Keeps the values of a refresh, and which of them have a status that changed since the previous refresh
+func (state *watchState) record(values api.ResponseData, fields []string) {
+	rows := values.AsArray()
+	id := idField(fields)
+	statuses := map[string]string{}
+	changed := []bool{}
+	for _, row := range rows {
+		key := cell(row[id])
+		status := cell(row["status"])
+		statuses[key] = status
+		previous, ok := state.statuses[key]
+		changed = append(changed, ok && previous != status)
+	}
+	state.values = rows
+	state.statuses = statuses
+	state.changed = changed
+}
+
+// Whether the last refresh printed values and every one of them matches the condition.
+// A refresh without values, such as a list before the instances are created, keeps watching.
+func (state *watchState) holds(until *Filter) bool {
+	if len(state.values) == 0 {
+		return false
+	}
+	for _, value := range state.values {
+		if !until.Matches(value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package report
+
+import (
+	"strconv"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const (
+	FormatJson     = "json"
+	FormatCsv      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+var ValidFormats = []string{FormatJson, FormatCsv, FormatMarkdown}
+
+// Everything run in the tenants the default credential has access to
+type Inventory struct {
+	Summary Summary           `json:"summary"`
+	Tenants []TenantInventory `json:"tenants"`
+}
+
+type Summary struct {
+	Tenants             int    `json:"tenants"`
+	Instances           int    `json:"instances"`
+	CustomerManagedKeys int    `json:"customer_managed_keys"`
+	GraphQLDataApis     int    `json:"graphql_data_apis"`
+	TotalMemory         string `json:"total_memory"`
+	// Instance counts by type, region and cloud provider
+	ByType          map[string]int `json:"instances_by_type"`
+	ByRegion        map[string]int `json:"instances_by_region"`
+	ByCloudProvider map[string]int `json:"instances_by_cloud_provider"`
+}
+
+type TenantInventory struct {
+	Id                  string              `json:"id"`
+	Name                string              `json:"name"`
+	Instances           []InstanceInventory `json:"instances"`
+	CustomerManagedKeys []CMKInventory      `json:"customer_managed_keys"`
+}
+
+type InstanceInventory struct {
+	Id                   string                    `json:"id"`
+	Name                 string                    `json:"name"`
+	Status               string                    `json:"status"`
+	Type                 string                    `json:"type"`
+	CloudProvider        string                    `json:"cloud_provider"`
+	Region               string                    `json:"region"`
+	Memory               string                    `json:"memory"`
+	Storage              string                    `json:"storage"`
+	CustomerManagedKeyId string                    `json:"customer_managed_key_id"`
+	GraphQLDataApis      []GraphQLDataApiInventory `json:"graphql_data_apis"`
+}
+
+type CMKInventory struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GraphQLDataApiInventory struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Url    string `json:"url"`
+}
+
+// Walks the tenants, their instances with full details and customer managed keys, and the GraphQL Data APIs of every instance.
+// The lists of every tenant and the GraphQL Data APIs are requested at most concurrency at once, and the details of instances
+// as with api.GetInstances. GraphQL Data APIs are only collected when the beta is enabled.
+func CollectInventory(cfg *clicfg.Config, concurrency int) (*Inventory, error) {
+	tenants, err := api.ListTenants(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &Inventory{Tenants: make([]TenantInventory, len(tenants))}
+
+	instanceLists := make([][]map[string]any, len(tenants))
+	cmkLists := make([][]map[string]any, len(tenants))
+	errs := make([]error, 2*len(tenants))
+	api.RunConcurrently(2*len(tenants), concurrency, 0, func(i int) {
+		tenantId := api.StringValue(tenants[i/2], "id")
+		if i%2 == 0 {
+			instanceLists[i/2], errs[i] = api.ListInstances(cfg, tenantId)
+		} else {
+			cmkLists[i/2], errs[i] = api.ListCMKs(cfg, tenantId)
+		}
+	})
+	if err := firstError(errs); err != nil {
+		return nil, err
+	}
+
+	// Instances of every tenant, with the index of their tenant
+	instances := []map[string]any{}
+	instanceTenants := []int{}
+	for i, tenant := range tenants {
+		inventory.Tenants[i] = TenantInventory{
+			Id:                  api.StringValue(tenant, "id"),
+			Name:                api.StringValue(tenant, "name"),
+			Instances:           []InstanceInventory{},
+			CustomerManagedKeys: []CMKInventory{},
+		}
+		for _, instance := range instanceLists[i] {
+			instances = append(instances, instance)
+			instanceTenants = append(instanceTenants, i)
+		}
+		for _, cmk := range cmkLists[i] {
+			inventory.Tenants[i].CustomerManagedKeys = append(inventory.Tenants[i].CustomerManagedKeys, CMKInventory{
+				Id:   api.StringValue(cmk, "id"),
+				Name: api.StringValue(cmk, "name"),
+			})
+		}
+	}
+
+	details, err := api.GetInstances(cfg, instances)
+	if err != nil {
+		return nil, err
+	}
+	collected := make([]InstanceInventory, len(details))
+	errs = make([]error, len(details))
+	api.RunConcurrently(len(details), concurrency, 0, func(i int) {
+		collected[i], errs[i] = collectInstance(cfg, details[i])
+	})
+	if err := firstError(errs); err != nil {
+		return nil, err
+	}
+	for i, instance := range collected {
+		inventory.Tenants[instanceTenants[i]].Instances = append(inventory.Tenants[instanceTenants[i]].Instances, instance)
+	}
+
+	inventory.Summary = summarize(inventory.Tenants)
+	return inventory, nil
+}
+
+// Collects an instance from its details, with its GraphQL Data APIs when the beta is enabled
+func collectInstance(cfg *clicfg.Config, details map[string]any) (InstanceInventory, error) {
+	instance := InstanceInventory{
+		Id:                   api.StringValue(details, "id"),
+		Name:                 api.StringValue(details, "name"),
+		Status:               api.StringValue(details, "status"),
+		Type:                 api.StringValue(details, "type"),
+		CloudProvider:        api.StringValue(details, "cloud_provider"),
+		Region:               api.StringValue(details, "region"),
+		Memory:               api.StringValue(details, "memory"),
+		Storage:              api.StringValue(details, "storage"),
+		CustomerManagedKeyId: api.StringValue(details, "customer_managed_key_id"),
+		GraphQLDataApis:      []GraphQLDataApiInventory{},
+	}
+
+	if !cfg.Aura.AuraBetaEnabled() {
+		return instance, nil
+	}
+
+	graphQLDataApis, err := api.ListGraphQLDataApis(cfg, instance.Id)
+	if err != nil {
+		return InstanceInventory{}, err
+	}
+	for _, graphQLDataApi := range graphQLDataApis {
+		instance.GraphQLDataApis = append(instance.GraphQLDataApis, GraphQLDataApiInventory{
+			Id:     api.StringValue(graphQLDataApi, "id"),
+			Name:   api.StringValue(graphQLDataApi, "name"),
+			Status: api.StringValue(graphQLDataApi, "status"),
+			Url:    api.StringValue(graphQLDataApi, "url"),
+		})
+	}
+	return instance, nil
+}
+
+func summarize(tenants []TenantInventory) Summary {
+	summary := Summary{
+		Tenants:         len(tenants),
+		ByType:          map[string]int{},
+		ByRegion:        map[string]int{},
+		ByCloudProvider: map[string]int{},
+	}
+
+	totalMemory := 0.0
+	for _, tenant := range tenants {
+		summary.CustomerManagedKeys += len(tenant.CustomerManagedKeys)
+		for _, instance := range tenant.Instances {
+			summary.Instances++
+			summary.GraphQLDataApis += len(instance.GraphQLDataApis)
+			summary.ByType[instance.Type]++
+			summary.ByRegion[instance.Region]++
+			summary.ByCloudProvider[instance.CloudProvider]++
+			// Memory that cannot be parsed counts as none
+			memory, _ := api.MemoryInGB(instance.Memory)
+			totalMemory += memory
+		}
+	}
+	summary.TotalMemory = strconv.FormatFloat(totalMemory, 'f', -1, 64) + "GB"
+
+	return summary
+}
+
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{"kind", "tenant_id", "tenant_name", "instance_id", "id", "name", "status", "type", "cloud_provider", "region", "memory", "storage", "customer_managed_key_id", "url"}
+
+// Writes the inventory in a format. JSON and Markdown contain the summary and the details,
+// CSV only contains the details, one row per resource, as a summary does not fit in its single table.
+func (inventory *Inventory) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatCsv:
+		return inventory.writeCsv(w)
+	case FormatMarkdown:
+		return inventory.writeMarkdown(w)
+	default:
+		encoded, err := json.MarshalIndent(inventory, "", "\t")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(encoded))
+		return err
+	}
+}
+
+func (inventory *Inventory) writeCsv(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	rows := [][]string{csvHeader}
+	for _, tenant := range inventory.Tenants {
+		for _, instance := range tenant.Instances {
+			rows = append(rows, []string{"instance", tenant.Id, tenant.Name, "", instance.Id, instance.Name, instance.Status, instance.Type, instance.CloudProvider, instance.Region, instance.Memory, instance.Storage, instance.CustomerManagedKeyId, ""})
+			for _, graphQLDataApi := range instance.GraphQLDataApis {
+				rows = append(rows, []string{"graphql-data-api", tenant.Id, tenant.Name, instance.Id, graphQLDataApi.Id, graphQLDataApi.Name, graphQLDataApi.Status, "", "", "", "", "", "", graphQLDataApi.Url})
+			}
+		}
+		for _, cmk := range tenant.CustomerManagedKeys {
+			rows = append(rows, []string{"customer-managed-key", tenant.Id, tenant.Name, "", cmk.Id, cmk.Name, "", "", "", "", "", "", "", ""})
+		}
+	}
+	return writer.WriteAll(rows)
+}
+
+func (inventory *Inventory) writeMarkdown(w io.Writer) error {
+	summary := inventory.Summary
+	var b strings.Builder
+
+	b.WriteString("# Aura inventory\n\n## Summary\n\n")
+	writeMarkdownTable(&b, []string{"Tenants", "Instances", "Customer managed keys", "GraphQL Data APIs", "Total memory"}, [][]string{{
+		strconv.Itoa(summary.Tenants), strconv.Itoa(summary.Instances), strconv.Itoa(summary.CustomerManagedKeys), strconv.Itoa(summary.GraphQLDataApis), summary.TotalMemory,
+	}})
+	writeMarkdownCounts(&b, "Instances by type", "Type", summary.ByType)
+	writeMarkdownCounts(&b, "Instances by region", "Region", summary.ByRegion)
+	writeMarkdownCounts(&b, "Instances by cloud provider", "Cloud provider", summary.ByCloudProvider)
+
+	for _, tenant := range inventory.Tenants {
+		fmt.Fprintf(&b, "## Tenant %s (%s)\n\n", tenant.Name, tenant.Id)
+
+		instances := [][]string{}
+		graphQLDataApis := [][]string{}
+		for _, instance := range tenant.Instances {
+			instances = append(instances, []string{instance.Id, instance.Name, instance.Status, instance.Type, instance.CloudProvider, instance.Region, instance.Memory, instance.Storage, instance.CustomerManagedKeyId})
+			for _, graphQLDataApi := range instance.GraphQLDataApis {
+				graphQLDataApis = append(graphQLDataApis, []string{instance.Id, graphQLDataApi.Id, graphQLDataApi.Name, graphQLDataApi.Status, graphQLDataApi.Url})
+			}
+		}
+		cmks := [][]string{}
+		for _, cmk := range tenant.CustomerManagedKeys {
+			cmks = append(cmks, []string{cmk.Id, cmk.Name})
+		}
+
+		b.WriteString("### Instances\n\n")
+		writeMarkdownTable(&b, []string{"ID", "Name", "Status", "Type", "Cloud provider", "Region", "Memory", "Storage", "Customer managed key"}, instances)
+		if len(graphQLDataApis) > 0 {
+			b.WriteString("### GraphQL Data APIs\n\n")
+			writeMarkdownTable(&b, []string{"Instance", "ID", "Name", "Status", "URL"}, graphQLDataApis)
+		}
+		if len(cmks) > 0 {
+			b.WriteString("### Customer managed keys\n\n")
+			writeMarkdownTable(&b, []string{"ID", "Name"}, cmks)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownCounts(b *strings.Builder, title string, header string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	keys := []string{}
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	rows := [][]string{}
+	for _, key := range keys {
+		rows = append(rows, []string{key, strconv.Itoa(counts[key])})
+	}
+	fmt.Fprintf(b, "### %s\n\n", title)
+	writeMarkdownTable(b, []string{header, "Instances"}, rows)
+}
+
+func writeMarkdownTable(b *strings.Builder, header []string, rows [][]string) {
+	writeMarkdownRow(b, header)
+	separator := make([]string, len(header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeMarkdownRow(b, separator)
+	for _, row := range rows {
+		writeMarkdownRow(b, row)
+	}
+	b.WriteString("\n")
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.ReplaceAll(cell, "|", `\|`)
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
@@ -0,0 +1,105 @@
+package apply
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/declarative"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/plan"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		file        string
+		prune       bool
+		autoApprove bool
+	)
+
+	const (
+		fileFlag        = "file"
+		pruneFlag       = "prune"
+		autoApproveFlag = "auto-approve"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Creates, updates and deletes your Aura resources to match a spec",
+		Long: `This command compares the desired state of your Aura resources, declared in a spec, with their live state, shows the changes needed and then makes them once confirmed.
+
+The spec is read from a YAML or JSON file, or from every such file below a directory. Each document declares one resource with a kind of Instance, CustomerManagedKey or GraphQLDataApi. Resources are matched with live resources by name, within their tenant or instance.
+
+Instance memory and GraphQL Data API type definitions are updated in place. Authentication providers that differ from the spec are replaced, and other differences are reported as errors as they cannot be changed.
+
+With --prune, live resources that are not declared in the spec are deleted. Only kinds of resources present in the spec are pruned, within the tenants and instances the spec declares resources in.
+
+Initial instance credentials and API keys are printed as resources are created. It is important to store them as they cannot be retrieved again.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
+
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			spec, err := declarative.Load(cfg.Aura.Fs(), file)
+			if err != nil {
+				return err
+			}
+
+			p, err := declarative.NewPlan(cfg, spec, prune)
+			if err != nil {
+				return err
+			}
+
+			if err := plan.PrintPlan(cmd, cfg, p); err != nil {
+				return err
+			}
+			if len(p.Actions) == 0 {
+				return nil
+			}
+
+			if !autoApprove {
+				cmd.Print("Do you want to apply these changes? Only 'yes' will be accepted: ")
+				answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if strings.TrimSpace(answer) != "yes" {
+					cmd.Println("Apply cancelled")
+					return nil
+				}
+			}
+
+			if err := p.Apply(cmd, cfg); err != nil {
+				return err
+			}
+
+			cmd.Printf("Applied %d changes\n", len(p.Actions))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, fileFlag, "f", "", "(required) Path to a spec file, or a directory of spec files")
+	cmd.MarkFlagRequired(fileFlag)
+
+	cmd.Flags().BoolVar(&prune, pruneFlag, false, "Deletes live resources that are not declared in the spec")
+	cmd.Flags().BoolVar(&autoApprove, autoApproveFlag, false, "Applies the changes without asking for confirmation")
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
+
+	return cmd
+}
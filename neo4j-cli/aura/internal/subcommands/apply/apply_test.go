@@ -0,0 +1,284 @@
+package apply_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestApply(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "4GB"
+		}
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"username": "neo4j",
+			"password": "letMeIn123!",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "professional-db",
+			"name": "Staging"
+		}
+	}`)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "updating",
+			"memory": "8GB"
+		}
+	}`)
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: gcp
+region: europe-west1
+memory: 8GB
+---
+kind: Instance
+name: Staging
+tenant_id: YOUR_TENANT_ID
+type: professional-db
+cloud_provider: gcp
+region: europe-west1
+memory: 2GB
+`)
+
+	helper.ExecuteCommand("apply -f /specs/aura.yaml --auto-approve --output table")
+
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{
+		"name": "Staging",
+		"tenant_id": "YOUR_TENANT_ID",
+		"type": "professional-db",
+		"version": "5",
+		"cloud_provider": "gcp",
+		"region": "europe-west1",
+		"memory": "2GB"
+	}`)
+	updateMock.AssertCalledTimes(1)
+	updateMock.AssertCalledWithBody(`{"memory": "8GB"}`)
+
+	helper.AssertOut(`
+┌────────┬──────────┬────────────┬────────────────┬───────────────────┐
+│ ACTION │ KIND     │ NAME       │ PARENT         │ CHANGES           │
+├────────┼──────────┼────────────┼────────────────┼───────────────────┤
+│ create │ Instance │ Staging    │ YOUR_TENANT_ID │                   │
+│ update │ Instance │ Production │ YOUR_TENANT_ID │ memory: 4GB → 8GB │
+└────────┴──────────┴────────────┴────────────────┴───────────────────┘
+Creating Instance Staging...
+┌──────────┬─────────┬────────────────┬─────────────────────┬──────────┬─────────────┬────────────────┬──────────────┬─────────────────┐
+│ ID       │ NAME    │ TENANT_ID      │ CONNECTION_URL      │ USERNAME │ PASSWORD    │ CLOUD_PROVIDER │ REGION       │ TYPE            │
+├──────────┼─────────┼────────────────┼─────────────────────┼──────────┼─────────────┼────────────────┼──────────────┼─────────────────┤
+│ db1d1234 │ Staging │ YOUR_TENANT_ID │ YOUR_CONNECTION_URL │ neo4j    │ letMeIn123! │ gcp            │ europe-west1 │ professional-db │
+└──────────┴─────────┴────────────────┴─────────────────────┴──────────┴─────────────┴────────────────┴──────────────┴─────────────────┘
+Updating Instance Production...
+┌──────────┬────────────┬───────────┬──────────┬────────────────┬────────────────┬────────┬──────┬────────┐
+│ ID       │ NAME       │ TENANT_ID │ STATUS   │ CONNECTION_URL │ CLOUD_PROVIDER │ REGION │ TYPE │ MEMORY │
+├──────────┼────────────┼───────────┼──────────┼────────────────┼────────────────┼────────┼──────┼────────┤
+│ 2f49c2b3 │ Production │           │ updating │                │                │        │      │ 8GB    │
+└──────────┴────────────┴───────────┴──────────┴────────────────┴────────────────┴────────┴──────┴────────┘
+Applied 2 changes
+`)
+}
+
+func TestApplyCancelled(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, "")
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Free
+tenant_id: YOUR_TENANT_ID
+type: free-db
+`)
+	helper.SetInput("no\n")
+
+	helper.ExecuteCommand("apply -f /specs/aura.yaml --output table")
+
+	createMock.AssertCalledTimes(0)
+	helper.AssertOut(`
+┌────────┬──────────┬──────┬────────────────┬─────────┐
+│ ACTION │ KIND     │ NAME │ PARENT         │ CHANGES │
+├────────┼──────────┼──────┼────────────────┼─────────┤
+│ create │ Instance │ Free │ YOUR_TENANT_ID │         │
+└────────┴──────────┴──────┴────────────────┴─────────┘
+Do you want to apply these changes? Only 'yes' will be accepted: Apply cancelled
+`)
+}
+
+func TestApplyGraphQLDataApi(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "afdb4e9d",
+				"name": "movies",
+				"status": "ready"
+			},
+			{
+				"id": "c2f1ef8a",
+				"name": "legacy",
+				"status": "ready"
+			}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "movies",
+			"status": "ready",
+			"type_definitions": "dHlwZSBNb3ZpZSB7IHRpdGxlOiBTdHJpbmcgfQ=="
+		}
+	}`).AddResponse(http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "movies",
+			"status": "ready"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d/auth-providers", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794",
+				"name": "default",
+				"type": "api-key",
+				"enabled": true
+			},
+			{
+				"id": "9a1fb2c3",
+				"name": "jwks",
+				"type": "jwks",
+				"enabled": false,
+				"url": "https://example.com/.well-known/jwks.json"
+			}
+		]
+	}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusAccepted, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "movies",
+			"status": "updating"
+		}
+	}`)
+	deleteAuthProviderMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d/auth-providers/9a1fb2c3", http.StatusAccepted, `{"data": {"id": "9a1fb2c3"}}`)
+	createAuthProviderMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d/auth-providers", http.StatusAccepted, `{
+		"data": {
+			"id": "4f2b0c1d",
+			"name": "jwks",
+			"type": "jwks",
+			"enabled": true,
+			"url": "https://example.com/.well-known/jwks.json"
+		}
+	}`)
+	deleteGraphQLDataApiMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3/data-apis/graphql/c2f1ef8a", http.StatusAccepted, `{"data": {"id": "c2f1ef8a"}}`)
+
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+---
+kind: GraphQLDataApi
+name: movies
+instance: Production
+type_definitions_file: movies.graphql
+auth_providers:
+  - name: default
+    type: api-key
+    enabled: true
+  - name: jwks
+    type: jwks
+    enabled: true
+    url: https://example.com/.well-known/jwks.json
+`)
+	helper.SetFile("/specs/movies.graphql", "type Movie { title: String, released: Int }")
+
+	helper.ExecuteCommand("apply -f /specs/aura.yaml --prune --auto-approve")
+
+	patchMock.AssertCalledTimes(1)
+	patchMock.AssertCalledWithBody(`{"type_definitions": "dHlwZSBNb3ZpZSB7IHRpdGxlOiBTdHJpbmcsIHJlbGVhc2VkOiBJbnQgfQ=="}`)
+	getMock.AssertCalledTimes(2)
+	deleteAuthProviderMock.AssertCalledTimes(1)
+	createAuthProviderMock.AssertCalledTimes(1)
+	createAuthProviderMock.AssertCalledWithBody(`{"name": "jwks", "type": "jwks", "enabled": true, "url": "https://example.com/.well-known/jwks.json"}`)
+	deleteGraphQLDataApiMock.AssertCalledTimes(1)
+}
+
+func TestApplyGraphQLDataApiWithoutBeta(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+---
+kind: GraphQLDataApi
+name: movies
+instance: Production
+type_definitions_file: movies.graphql
+`)
+	helper.SetFile("/specs/movies.graphql", "type Movie { title: String }")
+
+	helper.ExecuteCommand("apply -f /specs/aura.yaml --auto-approve")
+
+	helper.AssertErr("Error: GraphQLDataApi resources require the beta to be enabled, use the config set subcommand to set beta-enabled to true")
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Relates to the local audit log of mutating requests",
+		Long: `Every request made by the CLI that changes an Aura resource is recorded in an append-only audit log, one JSON object per line.
+
+The audit log is written to the CLI configuration directory by default. It can be relocated with the audit-log-path config key and disabled by setting the audit-log-enabled config key to false.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
+
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
+
+	cmd.AddCommand(NewListCmd(cfg))
+
+	return cmd
+}
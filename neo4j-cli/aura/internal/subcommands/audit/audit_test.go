@@ -0,0 +1,113 @@
+package audit_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+var defaultAuditLogPath = filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "audit.jsonl")
+
+func TestAuditLogRecordsMutatingRequests(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{
+		"data": {
+			"id": "2f49c2b3",
+			"status": "pausing"
+		}
+	}`)
+
+	helper.ExecuteCommand("instance pause 2f49c2b3")
+
+	log := helper.ReadFile(defaultAuditLogPath)
+
+	assert.Equal(t, "test-cred", gjson.Get(log, "credential").String())
+	assert.Equal(t, "instance pause 2f49c2b3", gjson.Get(log, "command").String())
+	assert.Equal(t, "POST", gjson.Get(log, "method").String())
+	assert.Equal(t, "/instances/2f49c2b3/pause", gjson.Get(log, "path").String())
+	assert.Equal(t, int64(http.StatusAccepted), gjson.Get(log, "status").Int())
+	assert.Equal(t, "2f49c2b3", gjson.Get(log, "resource_id").String())
+	assert.NotEmpty(t, gjson.Get(log, "timestamp").String())
+}
+
+func TestAuditLogRecordsFailedRequests(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("DELETE /v1/customer-managed-keys/8c764ad8-4c4b-4c1e-9b5a-3fb6e8d6b1c1", http.StatusNotFound, `{
+		"errors": [
+			{
+				"message": "Customer managed key not found",
+				"reason": "not-found"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("customer-managed-key delete 8c764ad8-4c4b-4c1e-9b5a-3fb6e8d6b1c1")
+
+	log := helper.ReadFile(defaultAuditLogPath)
+
+	assert.Equal(t, "DELETE", gjson.Get(log, "method").String())
+	assert.Equal(t, int64(http.StatusNotFound), gjson.Get(log, "status").Int())
+	assert.Equal(t, "8c764ad8-4c4b-4c1e-9b5a-3fb6e8d6b1c1", gjson.Get(log, "resource_id").String())
+}
+
+func TestAuditLogDoesNotRecordGetRequests(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3"
+		}
+	}`)
+	helper.SetFile(defaultAuditLogPath, "")
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	assert.Equal(t, "", helper.ReadFile(defaultAuditLogPath))
+}
+
+func TestAuditLogWithRelocatedPath(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/resume", http.StatusAccepted, `{
+		"data": {
+			"id": "2f49c2b3",
+			"status": "resuming"
+		}
+	}`)
+	helper.SetConfigValue("aura.audit-log-path", "/var/log/aura/audit.jsonl")
+
+	helper.ExecuteCommand("instance resume 2f49c2b3")
+
+	log := helper.ReadFile("/var/log/aura/audit.jsonl")
+
+	assert.Equal(t, "/instances/2f49c2b3/resume", gjson.Get(log, "path").String())
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{
+		"data": {
+			"id": "2f49c2b3",
+			"status": "pausing"
+		}
+	}`)
+	helper.SetConfigValue("aura.audit-log-enabled", false)
+	helper.SetFile(defaultAuditLogPath, "")
+
+	helper.ExecuteCommand("instance pause 2f49c2b3")
+
+	assert.Equal(t, "", helper.ReadFile(defaultAuditLogPath))
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+const dateFormat = "2006-01-02"
+
+func NewListCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		since      string
+		until      string
+		resourceId string
+		credential string
+	)
+
+	const (
+		sinceFlag      = "since"
+		untilFlag      = "until"
+		resourceIdFlag = "resource-id"
+		credentialFlag = "credential"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Returns the records of the audit log",
+		Long: `This subcommand returns the records of the audit log, oldest first.
+
+Records can be filtered by date using --since and --until, which accept either a date (YYYY-MM-DD) or an RFC 3339 timestamp. A date given to --until includes the whole of that day.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sinceTime, err := parseTime(since, sinceFlag, false)
+			if err != nil {
+				return err
+			}
+			untilTime, err := parseTime(until, untilFlag, true)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			records, err := api.ReadAuditLog(cfg)
+			if err != nil {
+				return err
+			}
+
+			values := []map[string]any{}
+			for _, record := range records {
+				if resourceId != "" && record.ResourceId != resourceId {
+					continue
+				}
+				if credential != "" && record.Credential != credential {
+					continue
+				}
+				if !sinceTime.IsZero() || !untilTime.IsZero() {
+					timestamp, err := time.Parse(time.RFC3339, record.Timestamp)
+					if err != nil {
+						return clierr.NewFatalError("invalid timestamp in audit log: %s", record.Timestamp)
+					}
+					if !sinceTime.IsZero() && timestamp.Before(sinceTime) {
+						continue
+					}
+					if !untilTime.IsZero() && timestamp.After(untilTime) {
+						continue
+					}
+				}
+
+				values = append(values, map[string]any{
+					"timestamp":   record.Timestamp,
+					"credential":  record.Credential,
+					"command":     record.Command,
+					"method":      record.Method,
+					"path":        record.Path,
+					"status":      record.Status,
+					"resource_id": record.ResourceId,
+				})
+			}
+
+			if err := output.PrintBodyMap(cmd, cfg, api.NewResponseData(values), []string{"timestamp", "credential", "method", "path", "status", "resource_id", "command"}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, sinceFlag, "", "Only returns records from this date or time onwards")
+	cmd.Flags().StringVar(&until, untilFlag, "", "Only returns records up to this date or time")
+	cmd.Flags().StringVar(&resourceId, resourceIdFlag, "", "Only returns records relating to the resource with this ID")
+	cmd.Flags().StringVar(&credential, credentialFlag, "", "Only returns records of requests made with the credential of this name")
+
+	return cmd
+}
+
+func parseTime(value string, flag string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if date, err := time.Parse(dateFormat, value); err == nil {
+		if endOfDay {
+			return date.Add(24*time.Hour - time.Nanosecond), nil
+		}
+		return date, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, clierr.NewUsageError(`invalid argument "%s" for "--%s" flag: must be a date (YYYY-MM-DD) or an RFC 3339 timestamp`, value, flag)
+	}
+	return timestamp, nil
+}
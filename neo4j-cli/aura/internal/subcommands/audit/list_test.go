@@ -0,0 +1,70 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const auditLog = `{"timestamp":"2026-10-01T09:00:00Z","credential":"production","command":"instance pause 2f49c2b3","method":"POST","path":"/instances/2f49c2b3/pause","status":202,"resource_id":"2f49c2b3"}
+{"timestamp":"2026-10-02T10:30:00Z","credential":"staging","command":"instance update b51dc964 --memory 8GB","method":"PATCH","path":"/instances/b51dc964","status":202,"resource_id":"b51dc964"}
+{"timestamp":"2026-10-03T11:45:00Z","credential":"production","command":"instance resume 2f49c2b3","method":"POST","path":"/instances/2f49c2b3/resume","status":202,"resource_id":"2f49c2b3"}
+`
+
+func TestListAuditLog(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile(defaultAuditLogPath, auditLog)
+
+	helper.ExecuteCommand("audit list --resource-id 2f49c2b3")
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"command": "instance pause 2f49c2b3",
+				"credential": "production",
+				"method": "POST",
+				"path": "/instances/2f49c2b3/pause",
+				"resource_id": "2f49c2b3",
+				"status": 202,
+				"timestamp": "2026-10-01T09:00:00Z"
+			},
+			{
+				"command": "instance resume 2f49c2b3",
+				"credential": "production",
+				"method": "POST",
+				"path": "/instances/2f49c2b3/resume",
+				"resource_id": "2f49c2b3",
+				"status": 202,
+				"timestamp": "2026-10-03T11:45:00Z"
+			}
+		]
+	}`)
+}
+
+func TestListAuditLogByDateAndCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile(defaultAuditLogPath, auditLog)
+
+	helper.ExecuteCommand("audit list --since 2026-10-02 --until 2026-10-03 --credential production --output table")
+
+	helper.AssertOut(`
+┌──────────────────────┬────────────┬────────┬────────────────────────────┬────────┬─────────────┬──────────────────────────┐
+│ TIMESTAMP            │ CREDENTIAL │ METHOD │ PATH                       │ STATUS │ RESOURCE_ID │ COMMAND                  │
+├──────────────────────┼────────────┼────────┼────────────────────────────┼────────┼─────────────┼──────────────────────────┤
+│ 2026-10-03T11:45:00Z │ production │ POST   │ /instances/2f49c2b3/resume │    202 │ 2f49c2b3    │ instance resume 2f49c2b3 │
+└──────────────────────┴────────────┴────────┴────────────────────────────┴────────┴─────────────┴──────────────────────────┘
+`)
+}
+
+func TestListAuditLogWithInvalidDate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("audit list --since yesterday")
+
+	helper.AssertErr(`Error: invalid argument "yesterday" for "--since" flag: must be a date (YYYY-MM-DD) or an RFC 3339 timestamp`)
+}
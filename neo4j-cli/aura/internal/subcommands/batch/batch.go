@@ -0,0 +1,145 @@
+package batch
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+// newRoot creates the aura command for every step, so that the flags of a step are not kept for the next one
+func NewCmd(cfg *clicfg.Config, newRoot func() *cobra.Command) *cobra.Command {
+	var (
+		file            string
+		continueOnError bool
+	)
+
+	const (
+		fileFlag            = "file"
+		continueOnErrorFlag = "continue-on-error"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Runs aura commands from a script",
+		Long: `Runs the aura commands of a script, such as a runbook, one after the other. The script is read from a file, or from the standard input when no file is given. The config and the credentials are read once, and every step of the script shares the same access token and connections.
+
+Every line of the script is a step running an aura command, such as instance list, with or without the neo4j-cli aura prefix. Empty lines and lines starting with # are ignored. A step can be named by starting its line with the name and a colon:
+
+	create: instance create --name staging --type free-db --cloud-provider gcp --region europe-west1 --tenant-id YOUR_TENANT_ID
+	instance pause $(steps.create.id)
+
+The values printed by a step as its result, whatever the output format, can be used in the next steps with $(steps.<name>.<field>), such as $(steps.create.id) or $(steps.create.connection_url). Steps without a name are referred to by their number, such as $(steps.1.id). When a step printed several values, such as instance list, the field is preceded by the index of the value, such as $(steps.list.0.id).
+
+The whole script is checked before the first step is run. By default, the batch stops at the first step that fails, and the steps after it are skipped. With --continue-on-error, the next steps are run anyway. Steps using the values of a failed step fail too.
+
+Once all steps are run, a summary of the result of every step is printed to the standard error.
+
+When the script is read from the standard input, commands that ask for confirmation, such as instance delete, cannot read an answer and should be given --auto-approve.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			var (
+				script []byte
+				err    error
+			)
+			if file == "" || file == "-" {
+				script, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				script, err = afero.ReadFile(cfg.Aura.Fs(), file)
+			}
+			if err != nil {
+				return err
+			}
+
+			steps, err := parse(string(script))
+			if err != nil {
+				return err
+			}
+
+			names := map[string]int{}
+			results := make([]result, len(steps))
+			stopped := false
+			for i, s := range steps {
+				if s.name != "" {
+					names[s.name] = s.number
+				}
+				if stopped {
+					results[i].status = skipped
+					continue
+				}
+
+				results[i] = run(cmd, cfg, newRoot, s, names, results)
+				if results[i].status == failed && !continueOnError {
+					stopped = true
+				}
+			}
+
+			return printSummary(cmd.ErrOrStderr(), steps, results)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, fileFlag, "f", "", "Path to the script, which is read from the standard input when not given or -")
+	cmd.Flags().BoolVar(&continueOnError, continueOnErrorFlag, false, "Runs the next steps when a step fails, rather than skipping them")
+
+	return cmd
+}
+
+func run(cmd *cobra.Command, cfg *clicfg.Config, newRoot func() *cobra.Command, s step, names map[string]int, results []result) result {
+	args, err := s.expand(names, results)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: step %d: %s\n", s.number, err)
+		return result{status: failed}
+	}
+
+	var values api.ResponseData
+	if err := execute(cmd, cfg, newRoot, args, &values); err != nil {
+		return result{status: failed}
+	}
+	return result{status: succeeded, values: values}
+}
+
+// Errors are printed by the command, and reported again in the summary. A command that panics, such as when the
+// access token cannot be retrieved, fails its step rather than stopping the batch before the summary is printed.
+func execute(cmd *cobra.Command, cfg *clicfg.Config, newRoot func() *cobra.Command, args []string, values *api.ResponseData) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %s\n", err)
+		}
+	}()
+
+	root := newRoot()
+	root.SetArgs(args)
+	root.SetIn(cmd.InOrStdin())
+	root.SetOut(cmd.OutOrStdout())
+	root.SetErr(cmd.ErrOrStderr())
+	cfg.Aura.SetErrWriter(cmd.ErrOrStderr())
+	cfg.Aura.SetCommandArgs(args)
+	return root.ExecuteContext(output.WithCapture(cmd.Context(), values))
+}
+
+func printSummary(w io.Writer, steps []step, results []result) error {
+	fmt.Fprintln(w)
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "STEP\tNAME\tRESULT\tCOMMAND")
+	failures := 0
+	for i, s := range steps {
+		if results[i].status == failed {
+			failures++
+		}
+		fmt.Fprintf(table, "%d\t%s\t%s\t%s\n", s.number, s.name, results[i].status, s.command)
+	}
+	table.Flush()
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d steps failed", failures, len(steps))
+	}
+	return nil
+}
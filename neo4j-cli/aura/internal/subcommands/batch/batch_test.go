@@ -0,0 +1,257 @@
+package batch_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestBatch(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "Northwind", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID", "memory": "8GB"}
+	}`)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/b51dc964/pause", http.StatusAccepted, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "pausing", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	helper.SetFile("steps.txt", `# Pauses Northwind
+list: instance list --quiet
+neo4j-cli aura instance get $(steps.list.1.id) --output json
+
+instance pause "$(steps.2.id)" --columns id,status --output table
+`)
+
+	helper.ExecuteCommand("batch -f steps.txt")
+
+	listMock.AssertCalledTimes(1)
+	getMock.AssertCalledTimes(1)
+	pauseMock.AssertCalledTimes(1)
+	helper.AssertOut(`2f49c2b3
+b51dc964
+{
+	"data": {
+		"id": "b51dc964",
+		"memory": "8GB",
+		"name": "Northwind",
+		"status": "running",
+		"tenant_id": "YOUR_TENANT_ID"
+	}
+}
+┌──────────┬─────────┐
+│ ID       │ STATUS  │
+├──────────┼─────────┤
+│ b51dc964 │ pausing │
+└──────────┴─────────┘`)
+	helper.AssertErr(`STEP  NAME  RESULT     COMMAND
+1     list  succeeded  instance list --quiet
+2           succeeded  neo4j-cli aura instance get $(steps.list.1.id) --output json
+3           succeeded  instance pause "$(steps.2.id)" --columns id,status --output table`)
+}
+
+func TestBatchStopsAtFailedStep(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusNotFound, `{
+		"errors": [{"message": "DB not found: b51dc964", "reason": "db-not-found"}]
+	}`)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/b51dc964/pause", http.StatusAccepted, `{"data": {}}`)
+	helper.SetInput(`get: instance get b51dc964
+instance pause $(steps.get.id)
+`)
+
+	helper.ExecuteCommand("batch")
+
+	getMock.AssertCalledTimes(1)
+	pauseMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: [DB not found: b51dc964]
+
+STEP  NAME  RESULT   COMMAND
+1     get   failed   instance get b51dc964
+2           skipped  instance pause $(steps.get.id)
+Error: 1 of 2 steps failed`)
+}
+
+func TestBatchContinuesOnError(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusNotFound, `{
+		"errors": [{"message": "DB not found: b51dc964", "reason": "db-not-found"}]
+	}`)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/b51dc964/pause", http.StatusAccepted, `{"data": {}}`)
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [{"id": "YOUR_TENANT_ID", "name": "Production"}]
+	}`)
+	helper.SetInput(`get: instance get b51dc964
+instance pause $(steps.get.id)
+tenant list --quiet
+`)
+
+	helper.ExecuteCommand("batch --continue-on-error")
+
+	getMock.AssertCalledTimes(1)
+	pauseMock.AssertCalledTimes(0)
+	tenantMock.AssertCalledTimes(1)
+	helper.AssertOut("YOUR_TENANT_ID")
+	helper.AssertErr(`Error: [DB not found: b51dc964]
+Error: step 2: $(steps.get.id): the step printed no values
+
+STEP  NAME  RESULT     COMMAND
+1     get   failed     instance get b51dc964
+2           failed     instance pause $(steps.get.id)
+3           succeeded  tenant list --quiet
+Error: 2 of 3 steps failed`)
+}
+
+func TestBatchChecksScriptBeforeRunning(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		script   string
+		expected string
+	}{
+		"later step": {
+			script:   "instance get $(steps.list.0.id)\nlist: instance list",
+			expected: "Error: line 1: $(steps.list.0.id) does not refer to a previous step",
+		},
+		"unknown step": {
+			script:   "instance list\ninstance get $(steps.3.id)",
+			expected: "Error: line 2: $(steps.3.id) does not refer to a previous step",
+		},
+		"duplicate step": {
+			script:   "list: instance list\n\nlist: tenant list",
+			expected: "Error: line 3: step list is already defined",
+		},
+		"unavailable command": {
+			script:   "instance list\nshell",
+			expected: "Error: line 2: shell cannot be run in a batch",
+		},
+		"unclosed quote": {
+			script:   "instance list\ninstance get \"b51dc964",
+			expected: "Error: line 2: EOF found when expecting closing quote",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+			helper.SetInput(testCase.script)
+
+			helper.ExecuteCommand("batch")
+
+			mockHandler.AssertCalledTimes(0)
+			helper.AssertErr(testCase.expected)
+		})
+	}
+}
+
+func TestBatchFailsStepWhoseOutputCannotBeRendered(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}]
+	}`)
+	helper.SetInput(`instance list --output 'template={{index .tags 2}}'
+`)
+
+	helper.ExecuteCommand("batch")
+
+	helper.AssertErr(`Error: cannot execute output template: template: output:1:2: executing "output" at <index .tags 2>: error calling index: index of untyped nil
+
+STEP  NAME  RESULT  COMMAND
+1           failed  instance list --output 'template={{index .tags 2}}'
+Error: 1 of 1 steps failed`)
+}
+
+func TestBatchUsesResultOfStepPrintingSeveralTables(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{
+		"data": {
+			"id": "YOUR_TENANT_ID",
+			"name": "Production",
+			"instance_configurations": [{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"}]
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID/metrics-integration", http.StatusBadRequest, `{
+		"errors": [{"message": "This tenant has no instances eligible for metrics integration", "reason": "tenant-incapable-of-action"}]
+	}`)
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}]
+	}`)
+	helper.SetInput(`tenant: tenant get YOUR_TENANT_ID --output table
+instance list --tenant-id $(steps.tenant.id) --quiet
+`)
+
+	helper.ExecuteCommand("batch")
+
+	listMock.AssertCalledTimes(1)
+	listMock.AssertCalledWithQueryParam("tenantId", "YOUR_TENANT_ID")
+	helper.AssertErr(`
+STEP  NAME    RESULT     COMMAND
+1     tenant  succeeded  tenant get YOUR_TENANT_ID --output table
+2             succeeded  instance list --tenant-id $(steps.tenant.id) --quiet`)
+}
+
+func TestBatchFailsStepThatPanics(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{
+		"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID/metrics-integration", http.StatusAccepted, `{"data": {}}`)
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.SetInput(`tenant get YOUR_TENANT_ID
+instance list
+`)
+
+	helper.ExecuteCommand("batch")
+
+	listMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: unexpected statusCode 202
+
+STEP  NAME  RESULT   COMMAND
+1           failed   tenant get YOUR_TENANT_ID
+2           skipped  instance list
+Error: 1 of 2 steps failed`)
+}
+
+func TestBatchRecordsStepsInAuditLog(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/b51dc964/pause", http.StatusAccepted, `{
+		"data": {"id": "b51dc964", "status": "pausing"}
+	}`)
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/resume", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "status": "resuming"}
+	}`)
+	helper.SetFile("steps.txt", `instance pause b51dc964 -q
+instance resume 2f49c2b3 -q
+`)
+
+	helper.ExecuteCommand("batch -f steps.txt")
+
+	log := helper.ReadFile(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "audit.jsonl"))
+	records := strings.Split(strings.TrimSpace(log), "\n")
+	assert.Len(t, records, 2)
+	assert.Equal(t, "instance pause b51dc964 -q", gjson.Get(records[0], "command").String())
+	assert.Equal(t, "instance resume 2f49c2b3 -q", gjson.Get(records[1], "command").String())
+}
@@ -0,0 +1,173 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/shlex"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/commandline"
+)
+
+// A step is named by a first word ending with a colon, such as create: instance create
+var stepNamePattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):$`)
+
+// A variable refers to a value printed by a previous step, by its name or its number, such as $(steps.create.id) or $(steps.1.id)
+var variablePattern = regexp.MustCompile(`\$\(steps\.([A-Za-z0-9_-]+)((?:\.[A-Za-z0-9_-]+)+)\)`)
+
+type step struct {
+	number int
+	name   string
+	// The command as written in the script, without the name of the step
+	command string
+	args    []string
+}
+
+type status string
+
+const (
+	succeeded status = "succeeded"
+	failed    status = "failed"
+	skipped   status = "skipped"
+)
+
+type result struct {
+	status status
+	// The values printed by the step, nil when it printed none
+	values api.ResponseData
+}
+
+// Reads the steps of a script, with a command on every line. Empty lines and comments starting with # are ignored.
+// The whole script is checked before any step is run, so that a mistake does not leave a runbook half done.
+func parse(script string) ([]step, error) {
+	steps := []step{}
+	names := map[string]int{}
+	for i, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args, err := shlex.Split(line)
+		if err != nil {
+			return nil, clierr.NewUsageError("line %d: %s", i+1, err)
+		}
+
+		s := step{number: len(steps) + 1, command: line}
+		if match := stepNamePattern.FindStringSubmatch(args[0]); match != nil {
+			s.name = match[1]
+			if _, ok := names[s.name]; ok {
+				return nil, clierr.NewUsageError("line %d: step %s is already defined", i+1, s.name)
+			}
+			names[s.name] = s.number
+			args = args[1:]
+			s.command = strings.TrimSpace(strings.TrimPrefix(line, match[0]))
+		}
+		args = commandline.TrimPrefix(args)
+		if len(args) == 0 {
+			return nil, clierr.NewUsageError("line %d: missing command", i+1)
+		}
+		if commandline.IsUnavailable(args[0]) {
+			return nil, clierr.NewUsageError("line %d: %s cannot be run in a batch", i+1, args[0])
+		}
+
+		// Variables can only refer to the steps before
+		for _, arg := range args {
+			for _, match := range variablePattern.FindAllStringSubmatch(arg, -1) {
+				if number, ok := stepNumber(match[1], names); !ok || number >= s.number {
+					return nil, clierr.NewUsageError("line %d: %s does not refer to a previous step", i+1, match[0])
+				}
+			}
+		}
+		s.args = args
+		steps = append(steps, s)
+	}
+	return steps, nil
+}
+
+func stepNumber(reference string, names map[string]int) (int, bool) {
+	if number, err := strconv.Atoi(reference); err == nil {
+		return number, number > 0
+	}
+	number, ok := names[reference]
+	return number, ok
+}
+
+// Replaces the variables of the arguments with the values printed by the previous steps
+func (s step) expand(names map[string]int, results []result) ([]string, error) {
+	args := []string{}
+	for _, arg := range s.args {
+		var err error
+		arg = variablePattern.ReplaceAllStringFunc(arg, func(variable string) string {
+			match := variablePattern.FindStringSubmatch(variable)
+			number, _ := stepNumber(match[1], names)
+			value, lookupErr := lookup(results[number-1].values, strings.Split(match[2][1:], "."))
+			if lookupErr != nil && err == nil {
+				err = fmt.Errorf("%s: %w", variable, lookupErr)
+			}
+			return value
+		})
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// Looks up a value by its path, such as id or connection_url. When a step printed several values,
+// the path starts with the index of the value, such as 0.id.
+func lookup(values api.ResponseData, path []string) (string, error) {
+	if values == nil {
+		return "", fmt.Errorf("the step printed no values")
+	}
+	var current any
+	if index, err := strconv.Atoi(path[0]); err == nil {
+		rows := values.AsArray()
+		if index < 0 || index >= len(rows) {
+			return "", fmt.Errorf("the step printed %d values", len(rows))
+		}
+		current = rows[index]
+		path = path[1:]
+	} else {
+		rows := values.AsArray()
+		if len(rows) != 1 {
+			return "", fmt.Errorf("the step printed %d values, so the path must start with the index of a value", len(rows))
+		}
+		current = rows[0]
+	}
+
+	for _, key := range path {
+		switch value := current.(type) {
+		case map[string]any:
+			current = value[key]
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(value) {
+				return "", fmt.Errorf("no value at %s", key)
+			}
+			current = value[index]
+		default:
+			current = nil
+		}
+		if current == nil {
+			return "", fmt.Errorf("no value at %s", key)
+		}
+	}
+
+	switch value := current.(type) {
+	case string:
+		return value, nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case map[string]any, []any:
+		data, err := json.Marshal(value)
+		return string(data), err
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
@@ -10,7 +10,16 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Use:       "get <key>",
 		Short:     "Displays the specified configuration value",
 		ValidArgs: cfg.Aura.ValidConfigKeys[:],
-		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+			// Keys with a name, such as columns.instance-list, are not listed in the valid arguments
+			if !cfg.Aura.IsValidConfigKey(args[0]) {
+				return cobra.OnlyValidArgs(cmd, args)
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			value := cfg.Aura.Get(args[0])
 
@@ -38,3 +38,23 @@ func TestGetConfigBetaEnabled(t *testing.T) {
 
 	helper.AssertOut("true")
 }
+
+func TestGetConfigColumns(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.columns.tenant-list", "id,name")
+
+	helper.ExecuteCommand("config get columns.tenant-list")
+
+	helper.AssertOut("id,name")
+}
+
+func TestGetConfigWithInvalidKey(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config get invalid")
+
+	helper.AssertErr(`Error: invalid argument "invalid" for "aura config get"`)
+}
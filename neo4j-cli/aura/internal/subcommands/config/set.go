@@ -3,6 +3,7 @@ package config
 import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -10,6 +11,13 @@ func NewSetCmd(cfg *clicfg.Config) *cobra.Command {
 	return &cobra.Command{
 		Use:   "set <key> <value>",
 		Short: "Sets the specified configuration value to the provided value",
+		Long: `Sets the specified configuration value to the provided value.
+
+The default columns of a command are set with the key columns.<command>, where <command> is the command path separated with dashes, such as:
+
+	config set columns.instance-list id,name,status,memory,region
+
+Columns can be nested fields, such as metrics.integration. Setting the columns to an empty value restores the default columns of the command.`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.ExactArgs(2)(cmd, args); err != nil {
 				return err
@@ -20,18 +28,15 @@ func NewSetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if args[0] == "output" {
-				validOutputValue := false
-				for _, v := range clicfg.ValidOutputValues {
-					if v == args[1] {
-						validOutputValue = true
-						break
-					}
-				}
-				if !validOutputValue {
-					return clierr.NewUsageError("invalid output value specified: %s", args[1])
+				if err := output.ValidateOutput(args[1]); err != nil {
+					return err
 				}
 			}
 
+			if args[0] == "audit-log-enabled" && args[1] != "true" && args[1] != "false" {
+				return clierr.NewUsageError("invalid audit-log-enabled value specified: %s, must be one of true or false", args[1])
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -39,6 +39,41 @@ func TestSetConfigWithInvalidOutputValue(t *testing.T) {
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
 
+func TestSetConfigColumns(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set columns.instance-list id,name,status")
+
+	helper.AssertErr("")
+	helper.AssertConfigValue("aura.columns.instance-list", "id,name,status")
+}
+
+func TestSetConfigColumnsWithoutCommand(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set columns. id,name")
+
+	helper.AssertErr("Error: invalid config key specified: columns.")
+}
+
+func TestSetConfigWithYamlOutputValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set output yaml")
+
+	helper.AssertErr("")
+	helper.AssertConfigValue("aura.output", "yaml")
+}
+
 func TestSetBetaEnabledConfig(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -55,3 +90,25 @@ func TestSetBetaEnabledConfig(t *testing.T) {
 	helper.AssertConfigValue("aura.base-url", "https://api.neo4j.io/v1")
 	helper.AssertConfigValue("aura.beta-enabled", "false")
 }
+
+func TestSetConfigAuditLogEnabled(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set audit-log-enabled false")
+
+	helper.AssertConfigValue("aura.audit-log-enabled", "false")
+}
+
+func TestSetConfigWithInvalidAuditLogEnabledValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set audit-log-enabled maybe")
+
+	helper.AssertErr("Error: invalid audit-log-enabled value specified: maybe, must be one of true or false")
+}
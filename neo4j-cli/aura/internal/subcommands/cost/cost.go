@@ -0,0 +1,40 @@
+package cost
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cost",
+		Short: "Relates to the cost of your Aura instances",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
+
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+	}
+
+	cmd.AddCommand(NewEstimateCmd(cfg))
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,163 @@
+package cost
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/cost"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewEstimateCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		tenantId      string
+		priceFile     string
+		_type         flags.InstanceType
+		cloudProvider flags.CloudProvider
+		region        string
+		memory        flags.Memory
+	)
+
+	const (
+		tenantIdFlag      = "tenant-id"
+		priceFileFlag     = "price-file"
+		typeFlag          = "type"
+		cloudProviderFlag = "cloud-provider"
+		regionFlag        = "region"
+		memoryFlag        = "memory"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Estimates the cost of instances",
+		Long: `This subcommand estimates the hourly and monthly cost of a proposed instance, given with --type, --memory, --cloud-provider and --region, or otherwise of all the existing instances of a tenant.
+
+Prices are read from the instance configurations of the tenant, as returned by the tenant get subcommand, when they have a price_per_hour. Otherwise they are read from a price file given with --price-file, in YAML or JSON:
+
+	currency: USD
+	prices:
+	  - type: enterprise-db
+	    memory: 8GB
+	    price_per_hour: 0.89
+	  - type: enterprise-db
+	    cloud_provider: aws
+	    region: eu-west-1
+	    memory: 8GB
+	    price_per_hour: 0.93
+
+Prices without a cloud provider or region apply to all of them, the most specific price is used. A month is counted as 730 hours.
+
+The cost of the existing instances is printed with the hourly and monthly totals, which only follow the instances as a summary line with table output.
+
+If no tenant ID is provided, the default tenant is used.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.Aura.DefaultTenant() == "" {
+				cmd.MarkFlagRequired(tenantIdFlag)
+			}
+			if _type != "" || memory != "" || cloudProvider != "" || region != "" {
+				cmd.MarkFlagRequired(typeFlag)
+				cmd.MarkFlagRequired(memoryFlag)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if tenantId == "" {
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+			var err error
+			tenantId, err = api.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
+			}
+
+			pricing, err := cost.NewPricing(cfg, tenantId, priceFile)
+			if err != nil {
+				return err
+			}
+
+			if _type != "" {
+				estimate, err := pricing.EstimateOrError(api.InstanceConfiguration{
+					Type:          _type.String(),
+					CloudProvider: cloudProvider.String(),
+					Region:        region,
+					Memory:        memory.String(),
+				})
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(estimate), cost.EstimateFields)
+			}
+
+			return estimateFleet(cmd, cfg, pricing, tenantId)
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The ID of the tenant to estimate the cost in")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+	cmd.Flags().StringVar(&priceFile, priceFileFlag, "", "A YAML or JSON file with prices, used for instance configurations without a price")
+	cmd.Flags().Var(&_type, typeFlag, "The type of a proposed instance")
+	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "The cloud provider of a proposed instance")
+	cmd.Flags().StringVar(&region, regionFlag, "", "The region of a proposed instance")
+	cmd.Flags().Var(&memory, memoryFlag, "The memory of a proposed instance")
+
+	return cmd
+}
+
+func estimateFleet(cmd *cobra.Command, cfg *clicfg.Config, pricing *cost.Pricing, tenantId string) error {
+	instances, err := api.ListInstances(cfg, tenantId)
+	if err != nil {
+		return err
+	}
+
+	details, err := api.GetInstances(cfg, instances)
+	if err != nil {
+		return err
+	}
+
+	// The totals add up the rows as they are printed, so that they match them
+	rows := []map[string]any{}
+	hourlyTotal, monthlyTotal := 0.0, 0.0
+	unpriced := 0
+	for _, instance := range details {
+		row := pricing.Estimate(cost.InstanceConfiguration(instance))
+		row["id"] = instance["id"]
+		row["name"] = instance["name"]
+		if hourly, ok := row["hourly_cost"].(float64); ok {
+			hourlyTotal += hourly
+			monthlyTotal += row["monthly_cost"].(float64)
+		} else {
+			unpriced++
+		}
+		rows = append(rows, row)
+	}
+
+	hourlyTotal = cost.Round(hourlyTotal)
+	monthlyTotal = cost.Round(monthlyTotal)
+	if output.IsStructured(cfg) {
+		return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(map[string]any{
+			"currency":           pricing.Currency(),
+			"instances":          rows,
+			"hourly_total":       hourlyTotal,
+			"monthly_total":      monthlyTotal,
+			"unpriced_instances": unpriced,
+		}), nil)
+	}
+
+	if err := output.PrintBodyMap(cmd, cfg, api.NewResponseData(rows), []string{"id", "name", "type", "cloud_provider", "region", "memory", "hourly_cost", "monthly_cost"}); err != nil {
+		return err
+	}
+	// Other row based formats such as csv are parsed, so cannot be followed by the totals
+	if !output.IsTable(cfg) {
+		return nil
+	}
+	cmd.Printf("Total: %.2f %s per hour, %.2f %s per month\n", hourlyTotal, pricing.Currency(), monthlyTotal, pricing.Currency())
+	if unpriced > 0 {
+		cmd.Printf("%d instances have no price and are not included in the total\n", unpriced)
+	}
+	return nil
+}
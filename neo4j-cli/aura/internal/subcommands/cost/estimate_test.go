@@ -0,0 +1,174 @@
+package cost_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const tenantId = "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+const priceFile = `currency: EUR
+prices:
+  - type: enterprise-db
+    memory: 8GB
+    price_per_hour: 0.9
+  - type: enterprise-db
+    cloud_provider: aws
+    region: eu-west-1
+    memory: 8GB
+    price_per_hour: 1
+`
+
+func mockTenant(helper *testutils.AuraTestHelper, configurations string) {
+	helper.NewRequestHandlerMock("GET /v1/tenants/"+tenantId, http.StatusOK, `{
+		"data": {
+			"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"name": "Production",
+			"instance_configurations": `+configurations+`
+		}
+	}`)
+}
+
+func TestEstimateProposedInstanceWithPriceFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[
+		{"cloud_provider": "aws", "region": "eu-west-1", "region_name": "Ireland", "type": "enterprise-db", "memory": "8GB", "storage": "16GB", "version": "5"}
+	]`)
+	helper.SetFile("prices.yaml", priceFile)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId + " --type enterprise-db --cloud-provider aws --region eu-west-1 --memory 8GB --price-file prices.yaml")
+
+	helper.AssertOutJson(`{
+		"data": {
+			"cloud_provider": "aws",
+			"currency": "EUR",
+			"hourly_cost": 1,
+			"memory": "8GB",
+			"monthly_cost": 730,
+			"region": "eu-west-1",
+			"type": "enterprise-db"
+		}
+	}`)
+}
+
+func TestEstimateProposedInstanceWithoutPrice(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[]`)
+	helper.SetFile("prices.yaml", priceFile)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId + " --type professional-db --cloud-provider gcp --region europe-west1 --memory 4GB --price-file prices.yaml")
+
+	helper.AssertErr("Error: no price found for a professional-db instance with 4GB memory in gcp europe-west1, add one to the price file\n")
+}
+
+func TestEstimateWithoutPrices(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[]`)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId)
+
+	helper.AssertErr("Error: the instance configurations of tenant 6981ace7-efe8-4f5c-b7c5-267b5162ce91 have no prices, use --price-file to provide a price file\n")
+}
+
+func TestEstimateFleetWithConfigurationPrices(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[
+		{"cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB", "price_per_hour": 0.8},
+		{"cloud_provider": "gcp", "region": "europe-west1", "type": "professional-db", "memory": "2GB", "price_per_hour": "0.25"}
+	]`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "orders", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "catalog", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"},
+			{"id": "432392ae", "name": "search", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "orders", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "catalog", "cloud_provider": "gcp", "region": "europe-west1", "type": "professional-db", "memory": "2GB"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/432392ae", http.StatusOK, `{
+		"data": {"id": "432392ae", "name": "search", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-ds", "memory": "16GB"}
+	}`)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId + " --output table")
+
+	helper.AssertOut(`┌──────────┬─────────┬─────────────────┬────────────────┬──────────────┬────────┬─────────────┬──────────────┐
+│ ID       │ NAME    │ TYPE            │ CLOUD_PROVIDER │ REGION       │ MEMORY │ HOURLY_COST │ MONTHLY_COST │
+├──────────┼─────────┼─────────────────┼────────────────┼──────────────┼────────┼─────────────┼──────────────┤
+│ 2f49c2b3 │ orders  │ enterprise-db   │ gcp            │ europe-west1 │ 8GB    │ 0.8         │ 584          │
+│ b51dc964 │ catalog │ professional-db │ gcp            │ europe-west1 │ 2GB    │ 0.25        │ 182.5        │
+│ 432392ae │ search  │ enterprise-ds   │ gcp            │ europe-west1 │ 16GB   │             │              │
+└──────────┴─────────┴─────────────────┴────────────────┴──────────────┴────────┴─────────────┴──────────────┘
+Total: 1.05 USD per hour, 766.50 USD per month
+1 instances have no price and are not included in the total
+`)
+}
+
+func TestEstimateFleetTotalsAddUpRows(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[
+		{"cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB", "price_per_hour": 0.894},
+		{"cloud_provider": "gcp", "region": "europe-west1", "type": "professional-db", "memory": "2GB", "price_per_hour": 0.257}
+	]`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "orders", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "catalog", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "orders", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "catalog", "cloud_provider": "gcp", "region": "europe-west1", "type": "professional-db", "memory": "2GB"}
+	}`)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId + " --output table")
+
+	helper.AssertOut(`┌──────────┬─────────┬─────────────────┬────────────────┬──────────────┬────────┬─────────────┬──────────────┐
+│ ID       │ NAME    │ TYPE            │ CLOUD_PROVIDER │ REGION       │ MEMORY │ HOURLY_COST │ MONTHLY_COST │
+├──────────┼─────────┼─────────────────┼────────────────┼──────────────┼────────┼─────────────┼──────────────┤
+│ 2f49c2b3 │ orders  │ enterprise-db   │ gcp            │ europe-west1 │ 8GB    │        0.89 │       652.62 │
+│ b51dc964 │ catalog │ professional-db │ gcp            │ europe-west1 │ 2GB    │        0.26 │       187.61 │
+└──────────┴─────────┴─────────────────┴────────────────┴──────────────┴────────┴─────────────┴──────────────┘
+Total: 1.15 USD per hour, 840.23 USD per month
+`)
+}
+
+func TestEstimateFleetWithCsvOutputPrintsOnlyInstances(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockTenant(&helper, `[
+		{"cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB", "price_per_hour": 0.8}
+	]`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "orders", "tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "orders", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB"}
+	}`)
+
+	helper.ExecuteCommand("cost estimate --tenant-id " + tenantId + " --output csv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`id,name,type,cloud_provider,region,memory,hourly_cost,monthly_cost
+2f49c2b3,orders,enterprise-db,gcp,europe-west1,8GB,0.8,584`)
+}
@@ -2,11 +2,12 @@ package credential
 
 import (
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/spf13/cobra"
 )
 
 func NewRemoveCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "remove <name>",
 		Short: "Removes a credential",
 		Args:  cobra.ExactArgs(1),
@@ -14,4 +15,8 @@ func NewRemoveCmd(cfg *clicfg.Config) *cobra.Command {
 			return cfg.Credentials.Aura.Remove(args[0])
 		},
 	}
+
+	completion.Arg(cmd, cfg, completion.Credential)
+
+	return cmd
 }
@@ -25,3 +25,18 @@ func TestUseCredentialIfDoesNotExist(t *testing.T) {
 
 	helper.AssertErr("Error: could not find credential with name test")
 }
+
+func TestUseCredentialCompletesNames(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]string{
+		{"name": "test", "client-id": "testclientid", "client-secret": "testclientsecret"},
+		{"name": "production", "client-id": "productionclientid", "client-secret": "productionclientsecret"},
+	})
+
+	helper.ExecuteCommand("__complete credential use t")
+
+	helper.AssertOut(`test	testclientid
+:4`)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -19,6 +20,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		tenantId      string
 		cloudProvider flags.CloudProvider
 		keyId         string
+		ifNotExists   bool
 		await         bool
 	)
 
@@ -29,6 +31,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		tenantIdFlag      = "tenant-id"
 		cloudProviderFlag = "cloud-provider"
 		keyIdFlag         = "key-id"
+		ifNotExistsFlag   = "if-not-exists"
 		awaitFlag         = "await"
 	)
 
@@ -41,7 +44,9 @@ Before you can use the key you will need to setup permissions for it. Log in to
 
 You can poll the current status of this operation by periodically getting the key details using the get subcommand.
 
-Once the key has a status of ready you can use it for creating new instances by setting the --customer-managed-key-id flag.`,
+Once the key has a status of ready you can use it for creating new instances by setting the --customer-managed-key-id flag.
+
+With --if-not-exists, an existing key with the same name in the tenant is returned instead of creating a new one, which makes it safe to retry the command. If the create request gets no response, it is retried unless the key is found to have been created.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if cfg.Aura.DefaultTenant() == "" {
 				cmd.MarkFlagRequired(tenantIdFlag)
@@ -50,7 +55,18 @@ Once the key has a status of ready you can use it for creating new instances by
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if tenantId == "" {
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+			var err error
+			tenantId, err = api.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
+			}
+
 			body := map[string]any{
+				"tenant_id":      tenantId,
 				"region":         region,
 				"name":           name,
 				"instance_type":  instanceType,
@@ -58,23 +74,32 @@ Once the key has a status of ready you can use it for creating new instances by
 				"key_id":         keyId,
 			}
 
-			if tenantId == "" {
-				body["tenant_id"] = cfg.Aura.DefaultTenant()
+			var (
+				resBody    []byte
+				statusCode int
+				created    = true
+			)
+			if ifNotExists {
+				resBody, statusCode, created, err = api.CreateIfNotExists(cfg, "/customer-managed-keys", body, func() (map[string]any, error) {
+					return api.FindCMKByName(cfg, tenantId, name)
+				})
 			} else {
-				body["tenant_id"] = tenantId
+				resBody, statusCode, err = api.MakeRequest(cfg, "/customer-managed-keys", &api.RequestConfig{
+					Method:   http.MethodPost,
+					PostBody: body,
+				})
 			}
-
-			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/customer-managed-keys", &api.RequestConfig{
-				Method:   http.MethodPost,
-				PostBody: body,
-			})
 			if err != nil {
 				return err
 			}
+			if !created {
+				cmd.Printf("A customer managed key named %s already exists, no new key has been created\n", name)
+			}
 			// NOTE: Instance delete should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for customer managed key to be ready...")
@@ -108,6 +133,7 @@ Once the key has a status of ready you can use it for creating new instances by
 	cmd.MarkFlagRequired(instanceTypeFlag)
 
 	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The Aura tenant/project ID")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
 
 	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "(required) The cloud provider hosting the instance.")
 	cmd.MarkFlagRequired(cloudProviderFlag)
@@ -115,6 +141,8 @@ Once the key has a status of ready you can use it for creating new instances by
 	cmd.Flags().StringVar(&keyId, keyIdFlag, "", "(required) Encryption Key ARN")
 	cmd.MarkFlagRequired(keyIdFlag)
 
+	cmd.Flags().BoolVar(&ifNotExists, ifNotExistsFlag, false, "Returns the existing customer managed key with the same name in the tenant instead of creating a new one")
+
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created customer managed key is ready.")
 
 	return cmd
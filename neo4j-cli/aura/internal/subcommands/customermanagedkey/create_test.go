@@ -125,3 +125,45 @@ func TestCreateCustomerManagedKeysWithoutTenantID(t *testing.T) {
 
 	helper.AssertErr("Error: required flag(s) \"tenant-id\" not set\n")
 }
+
+func TestCreateCustomerManagedKeyIfNotExistsWithExistingKey(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{
+				"id": "8c764ad8-5f5d-4b43-b8a9-86fbd0ce8e21",
+				"name": "Production Key",
+				"tenant_id": "dontpanic"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/8c764ad8-5f5d-4b43-b8a9-86fbd0ce8e21", http.StatusOK, `{
+		"data": {
+			"id": "8c764ad8-5f5d-4b43-b8a9-86fbd0ce8e21",
+			"name": "Production Key",
+			"created": "2024-01-31T14:06:57Z",
+			"cloud_provider": "aws",
+			"key_id": "arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			"region": "us-west-2",
+			"type": "enterprise-db",
+			"tenant_id": "dontpanic",
+			"status": "ready"
+		}
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/customer-managed-keys", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand(`customer-managed-key create --region us-west-2 --name "Production Key" --type enterprise-db --tenant-id dontpanic --cloud-provider aws --key-id arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab --if-not-exists --output table`)
+
+	listMock.AssertCalledWithQueryParam("tenantId", "dontpanic")
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut(`A customer managed key named Production Key already exists, no new key has been created
+┌──────────────────────────────────────┬────────────────┬───────────┬────────┬──────────────────────┬────────────────┬─────────────────────────────────────────────────────────────────────────────┬───────────┬───────────────┐
+│ ID                                   │ NAME           │ TENANT_ID │ STATUS │ CREATED              │ CLOUD_PROVIDER │ KEY_ID                                                                      │ REGION    │ TYPE          │
+├──────────────────────────────────────┼────────────────┼───────────┼────────┼──────────────────────┼────────────────┼─────────────────────────────────────────────────────────────────────────────┼───────────┼───────────────┤
+│ 8c764ad8-5f5d-4b43-b8a9-86fbd0ce8e21 │ Production Key │ dontpanic │ ready  │ 2024-01-31T14:06:57Z │ aws            │ arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab │ us-west-2 │ enterprise-db │
+└──────────────────────────────────────┴────────────────┴───────────┴────────┴──────────────────────┴────────────────┴─────────────────────────────────────────────────────────────────────────────┴───────────┴───────────────┘
+`)
+}
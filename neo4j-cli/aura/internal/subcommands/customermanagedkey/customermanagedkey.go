@@ -5,7 +5,7 @@ import (
 	"strings"
 
 	"github.com/neo4j/cli/common/clicfg"
-	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -19,18 +19,8 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
 
-			outputValue := cmd.Flags().Lookup("output").Value.String()
-			if outputValue != "" {
-				validOutputValue := false
-				for _, v := range clicfg.ValidOutputValues {
-					if v == outputValue {
-						validOutputValue = true
-						break
-					}
-				}
-				if !validOutputValue {
-					return clierr.NewUsageError("invalid output value specified: %s", outputValue)
-				}
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
 			}
 
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
@@ -42,6 +32,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.PersistentFlags().String("auth-url", "", "")
 	cmd.PersistentFlags().String("base-url", "", "")
 	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
 
 	cmd.AddCommand(NewCreateCmd(cfg))
 	cmd.AddCommand(NewDeleteCmd(cfg))
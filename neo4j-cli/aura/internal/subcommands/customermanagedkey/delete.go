@@ -6,11 +6,12 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delete <id>",
 		Short: "Deletes a customer managed key",
 		Long: `Deletes a Customer Managed Key from Aura.
@@ -18,8 +19,12 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 Note that you can only delete a Key if it is not being used by any instances, otherwise you will get an error with the reason field set to encryption-key-is-active.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
+			cmkId, err := api.ResolveCMKId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
 			_, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
@@ -35,4 +40,8 @@ Note that you can only delete a Key if it is not being used by any instances, ot
 			return nil
 		},
 	}
+
+	completion.Arg(cmd, cfg, completion.CustomerManagedKey)
+
+	return cmd
 }
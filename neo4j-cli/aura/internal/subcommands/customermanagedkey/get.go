@@ -6,19 +6,24 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get <id>",
 		Short: "Returns a customer managed key details",
 		Long:  `This subcommand returns details about a specific Customer Managed Key.`,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
+			cmkId, err := api.ResolveCMKId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -27,11 +32,19 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"}); err != nil {
+					return err
+				}
 
 			}
 
 			return nil
 		},
 	}
+
+	output.AddWatchFlags(cmd)
+
+	completion.Arg(cmd, cfg, completion.CustomerManagedKey)
+
+	return cmd
 }
@@ -50,6 +50,37 @@ func TestGetCustomerManagedKey(t *testing.T) {
 	}
 }
 
+func TestGetCustomerManagedKeyWithTimezone(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	cmkId := "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/customer-managed-keys/%s", cmkId), http.StatusOK, `{
+			"data": {
+				"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+				"name": "Instance01",
+				"created": "2024-01-31T14:06:57Z",
+				"status": "ready"
+			}
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("customer-managed-key get %s --output csv --columns id,created --timezone Europe/Berlin", cmkId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`id,created
+8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9,2024-01-31T15:06:57+01:00`)
+}
+
+func TestGetCustomerManagedKeyWithInvalidTimezone(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("customer-managed-key get 8c764aed --timezone Mars/Olympus_Mons")
+
+	helper.AssertErr(`Error: invalid argument "Mars/Olympus_Mons" for "--timezone" flag: must be "Local", "UTC" or a time zone name such as "Europe/London"`)
+}
+
 func TestGetCustomerManagedKeyNotFoundError(t *testing.T) {
 	for _, command := range []string{"customer-managed-key", "cmk"} {
 		helper := testutils.NewAuraTestHelper(t)
@@ -5,27 +5,53 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
-	var tenantId string
+	var (
+		tenantId       string
+		allCredentials bool
+		listOptions    output.ListOptions
+	)
+
+	const (
+		tenantIdFlag       = "tenant-id"
+		allCredentialsFlag = "all-credentials"
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of customer managed keys",
 		Long: `This subcommand returns a list containing a summary of each of your customer managed keys. To find out more about a specific key, retrieve the details using the get subcommand.
 
-You can filter keys in a particular tenant using --tenant-id. If the tenant flag is not specified, this endpoint lists all keys a user has access to across all tenants.`,
+You can filter keys in a particular tenant using --tenant-id. If the tenant flag is not specified, this endpoint lists all keys a user has access to across all tenants.
+
+With --all-credentials, the keys are listed with every stored credential rather than only the default one, and a credential column shows the credential each key was listed with.
+
+The listed keys can be filtered with --filter, such as --filter 'name~prod*', and sorted with --sort, such as --sort -name.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := "/customer-managed-keys"
+			cmd.SilenceUsage = true
+			if allCredentials {
+				cmks, err := api.ListWithAllCredentials(cfg, path, nil)
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.NewResponseData(cmks)), []string{api.CredentialField, "id", "name", "tenant_id"})
+			}
+
 			queryParams := make(map[string]string)
 			if tenantId != "" {
-				queryParams["tenantId"] = tenantId
+				resolvedTenantId, err := api.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+				queryParams["tenantId"] = resolvedTenantId
 			}
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
@@ -35,7 +61,9 @@ You can filter keys in a particular tenant using --tenant-id. If the tenant flag
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id"})
+				if err := output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.ParseBody(resBody)), []string{"id", "name", "tenant_id"}); err != nil {
+					return err
+				}
 
 			}
 
@@ -43,7 +71,14 @@ You can filter keys in a particular tenant using --tenant-id. If the tenant flag
 		},
 	}
 
-	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "An optional Tenant ID to filter customer managed keys in a tenant")
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "An optional Tenant ID to filter customer managed keys in a tenant")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+	cmd.Flags().BoolVar(&allCredentials, allCredentialsFlag, false, "Lists the customer managed keys of every stored credential")
+	cmd.MarkFlagsMutuallyExclusive(tenantIdFlag, allCredentialsFlag)
+
+	output.AddListFlags(cmd, &listOptions)
+
+	output.AddWatchFlags(cmd)
 
 	return cmd
 }
@@ -71,6 +71,16 @@ func TestListCustomerManagedKeysWithTenantId(t *testing.T) {
 		]
 		}`)
 
+		// 1234 could be a tenant ID prefix, so it is resolved against the tenants
+		helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1234",
+				"name": "Production"
+			}
+		]
+		}`)
+
 		helper.ExecuteCommand(fmt.Sprintf("%s list --tenant-id 1234", command))
 
 		mockHandler.AssertCalledTimes(1)
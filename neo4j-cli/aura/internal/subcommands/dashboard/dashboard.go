@@ -0,0 +1,123 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		tenantId string
+		interval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Opens a terminal dashboard of your Aura resources",
+		Long: `Opens a full-screen terminal dashboard listing the tenants, the instances of the selected tenant, and the snapshots and GraphQL Data APIs of the selected instance, with the details of the selected instance. The dashboard is refreshed in the background at every interval, so keys can be pressed while it is fetched.
+
+Keys:
+	↑ ↓ or k j	Selects the previous or next value of the focused pane
+	tab → or l	Focuses the next pane, ← or h the previous pane
+	p		Pauses the selected instance
+	r		Resumes the selected instance
+	s		Takes a snapshot of the selected instance
+	d		Deletes the selected instance
+	space		Refreshes the dashboard
+	q		Quits the dashboard
+
+Pausing, resuming, taking a snapshot and deleting must be confirmed by pressing y.
+
+GraphQL Data APIs are only listed when beta-enabled is set in the config.
+
+When the input is not a terminal, such as a pipe, keys are read from the input as they are, each once the values it selects are fetched, and the dashboard is drawn with the size given by the COLUMNS and LINES environment variables, 80x24 by default.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval <= 0 {
+				return clierr.NewUsageError("--refresh must be greater than 0")
+			}
+			cmd.SilenceUsage = true
+			if tenantId != "" {
+				var err error
+				if tenantId, err = api.ResolveTenantId(cfg, tenantId); err != nil {
+					return err
+				}
+			} else {
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+
+			out := cmd.OutOrStdout()
+			outFile, _ := out.(*os.File)
+			in, _ := cmd.InOrStdin().(*os.File)
+			interactive := in != nil && output.IsTerminal(in)
+			if interactive {
+				// Keys are read as they are pressed, without being echoed
+				state, err := term.MakeRaw(int(in.Fd()))
+				if err != nil {
+					return err
+				}
+				defer term.Restore(int(in.Fd()), state)
+				fmt.Fprint(out, enterScreen)
+				defer fmt.Fprint(out, leaveScreen)
+			}
+
+			keys := make(chan string)
+			done := make(chan struct{})
+			defer close(done)
+			go readKeys(cmd.InOrStdin(), keys, done)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			d := newDashboard(cfg, tenantId, done)
+			for {
+				width, height := screenSize(outFile)
+				fmt.Fprint(out, clearScreen+d.render(width, height))
+				// Keys read from a pipe are handled once the panes are loaded, so that they select the same values every time
+				next := keys
+				if d.loading && !interactive {
+					next = nil
+				}
+				select {
+				case key, ok := <-next:
+					if !ok || d.handleKey(key) {
+						fmt.Fprint(out, "\r\n")
+						return nil
+					}
+				case result := <-d.loads:
+					d.apply(result)
+				case <-ticker.C:
+					// A refresh still running is not started again
+					if !d.loading {
+						d.refresh()
+					}
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "The ID of the tenant to open the dashboard with, defaults to the default tenant or else the first tenant")
+	completion.Flag(cmd, cfg, "tenant-id", completion.Tenant)
+	cmd.Flags().DurationVar(&interval, "refresh", 10*time.Second, "The interval between refreshes of the dashboard")
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+
+	return cmd
+}
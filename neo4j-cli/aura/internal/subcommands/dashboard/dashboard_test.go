@@ -0,0 +1,153 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+// Mocks the resources of the dashboard, for the first load and a refresh
+func mockResources(helper testutils.AuraTestHelper) {
+	mock := func(path string, body string) {
+		helper.NewRequestHandlerMock(path, http.StatusOK, body).AddResponse(http.StatusOK, body)
+	}
+	mock("GET /v1/tenants", `{
+		"data": [
+			{"id": "YOUR_TENANT_ID", "name": "Production"},
+			{"id": "da045ab3-3b89-4f45-8b96-528f2e47cd13", "name": "Development"}
+		]
+	}`)
+	mock("GET /v1/instances", `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "Northwind", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	mock("GET /v1/instances/2f49c2b3", `{
+		"data": {"id": "2f49c2b3", "name": "Recommendations", "status": "running", "tenant_id": "YOUR_TENANT_ID", "region": "europe-west1", "memory": "8GB"}
+	}`)
+	mock("GET /v1/instances/b51dc964", `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID", "region": "us-central1", "memory": "4GB"}
+	}`)
+	mock("GET /v1/instances/2f49c2b3/snapshots", `{"data": []}`)
+	mock("GET /v1/instances/b51dc964/snapshots", `{
+		"data": [
+			{"snapshot_id": "afdb4e9d", "instance_id": "b51dc964", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"}
+		]
+	}`)
+}
+
+func TestDashboard(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockResources(helper)
+	helper.SetInput("q")
+
+	helper.ExecuteCommand("dashboard")
+
+	helper.AssertErr("")
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Aura dashboard - Production (YOUR_TENANT_ID)")
+	assert.Contains(t, out, "> Production")
+	assert.Contains(t, out, "> Northwind")
+	assert.Contains(t, out, "  Recommendations")
+	assert.Contains(t, out, "status                  running")
+	assert.Contains(t, out, "region                  us-central1")
+	assert.Contains(t, out, "> 2024-09-12T13:51:45Z AdHoc Completed")
+}
+
+func TestDashboardFetchesDetailsOfSelectedInstanceOnly(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [{"id": "YOUR_TENANT_ID", "name": "Production"}]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "Northwind", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	selectedMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "paused", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964/snapshots", http.StatusOK, `{"data": []}`)
+	otherMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Recommendations", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	helper.SetInput("q")
+
+	helper.ExecuteCommand("dashboard")
+
+	helper.AssertErr("")
+	selectedMock.AssertCalledTimes(1)
+	otherMock.AssertCalledTimes(0)
+	assert.Contains(t, helper.PrintOut(), "status                  paused")
+}
+
+func TestDashboardPausesSelectedInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	// Wide enough for the confirmation prompt
+	t.Setenv("COLUMNS", "120")
+	mockResources(helper)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "status": "pausing"}
+	}`)
+	helper.SetInput("jpyq")
+
+	helper.ExecuteCommand("dashboard")
+
+	helper.AssertErr("")
+	pauseMock.AssertCalledTimes(1)
+	out := helper.PrintOut()
+	assert.Contains(t, out, "region                  europe-west1")
+	assert.Contains(t, out, "Pause instance Recommendations (2f49c2b3)? Press y to confirm, any other key to cancel")
+	assert.Contains(t, out, "Started pausing instance Recommendations (2f49c2b3)")
+}
+
+func TestDashboardCancelsDelete(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("COLUMNS", "120")
+	mockResources(helper)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/b51dc964", http.StatusAccepted, `{"data": {}}`)
+	helper.SetInput("dnq")
+
+	helper.ExecuteCommand("dashboard")
+
+	helper.AssertErr("")
+	deleteMock.AssertCalledTimes(0)
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Delete instance Northwind (b51dc964)? Press y to confirm, any other key to cancel")
+	assert.Contains(t, out, "Cancelled")
+}
+
+func TestDashboardWithTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockResources(helper)
+	helper.SetInput("")
+
+	helper.ExecuteCommand("dashboard --tenant-id da045ab3")
+
+	helper.AssertErr("")
+	assert.Contains(t, helper.PrintOut(), "Aura dashboard - Development (da045ab3-3b89-4f45-8b96-528f2e47cd13)")
+}
+
+func TestDashboardWithInvalidRefresh(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("dashboard --refresh 0s")
+
+	helper.AssertErr("Error: --refresh must be greater than 0\n")
+}
@@ -0,0 +1,77 @@
+package dashboard
+
+import (
+	"bufio"
+	"io"
+)
+
+// Names of the special keys, other keys are named by the character they type
+const (
+	keyUp     = "up"
+	keyDown   = "down"
+	keyLeft   = "left"
+	keyRight  = "right"
+	keyTab    = "tab"
+	keyEnter  = "enter"
+	keyEscape = "escape"
+	keyCtrlC  = "ctrl+c"
+)
+
+// Reads keys until the end of the input, then closes the channel. Stops once done is closed,
+// without reading further keys, though a read that is already waiting for a key cannot be interrupted.
+func readKeys(r io.Reader, keys chan<- string, done <-chan struct{}) {
+	defer close(keys)
+	reader := bufio.NewReader(r)
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		char, _, err := reader.ReadRune()
+		if err != nil {
+			return
+		}
+		var key string
+		switch char {
+		case '\x1b':
+			key = readEscapeSequence(reader)
+		case '\t':
+			key = keyTab
+		case '\r', '\n':
+			key = keyEnter
+		case '\x03':
+			key = keyCtrlC
+		default:
+			key = string(char)
+		}
+		select {
+		case keys <- key:
+		case <-done:
+			return
+		}
+	}
+}
+
+// Arrow keys are sent as the escape character followed by [ and a letter, in the same read
+func readEscapeSequence(reader *bufio.Reader) string {
+	if reader.Buffered() < 2 {
+		return keyEscape
+	}
+	next, err := reader.Peek(2)
+	if err != nil || next[0] != '[' {
+		return keyEscape
+	}
+	reader.Discard(2)
+	switch next[1] {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	case 'C':
+		return keyRight
+	case 'D':
+		return keyLeft
+	}
+	return keyEscape
+}
@@ -0,0 +1,301 @@
+package dashboard
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+type pane int
+
+const (
+	tenantsPane pane = iota
+	instancesPane
+	snapshotsPane
+	dataApisPane
+	paneCount
+)
+
+var paneTitles = [paneCount]string{"Tenants", "Instances", "Snapshots", "Data APIs"}
+
+// The fields of each pane that identify its values, so that selections are kept across refreshes
+var paneIdFields = [paneCount]string{"id", "id", "snapshot_id", "id"}
+
+// An action on the selected instance, run once it is confirmed
+type action struct {
+	prompt string
+	run    func() (string, error)
+}
+
+// The values of the panes and their selection. Loads fetch them in the background on a copy, which the dashboard then shows.
+type panes struct {
+	// The instances are listed without their details, which are only fetched for the selected instance
+	lists    [paneCount][]map[string]any
+	selected [paneCount]int
+	// The details of the selected instance, as returned by instance get, nil until they are fetched
+	detail    map[string]any
+	refreshed time.Time
+}
+
+// The panes fetched by a load, with the generation of the load
+type loaded struct {
+	generation int
+	panes      panes
+	err        error
+}
+
+type dashboard struct {
+	panes
+	cfg   *clicfg.Config
+	focus pane
+	// A message about the last action or error, shown above the key bindings
+	message string
+	// The action waiting for confirmation, nil when there is none
+	pending *action
+	// Every load has a new generation, and only the panes of the last one are shown, as the selection may have changed since the others
+	generation int
+	loading    bool
+	loads      chan loaded
+	// Closed once the dashboard is closed, so that loads still running are discarded
+	done <-chan struct{}
+}
+
+// The first load is waited for, as there is nothing to show before it
+func newDashboard(cfg *clicfg.Config, tenantId string, done <-chan struct{}) *dashboard {
+	d := &dashboard{cfg: cfg, focus: instancesPane, loads: make(chan loaded), done: done}
+	if err := d.panes.loadTenants(cfg, tenantId); err != nil {
+		d.fail(err)
+	}
+	return d
+}
+
+// Fetches every pane again in the background, keeping the selected values
+func (d *dashboard) refresh() {
+	d.message = ""
+	d.load(func(ps *panes) error {
+		return ps.loadTenants(d.cfg, ps.selectedId(tenantsPane))
+	})
+}
+
+// Runs a load in the background on a copy of the panes, which is sent to the render loop once fetched
+func (d *dashboard) load(fetch func(ps *panes) error) {
+	d.generation++
+	d.loading = true
+	result := loaded{generation: d.generation, panes: d.panes}
+	go func() {
+		result.err = fetch(&result.panes)
+		select {
+		case d.loads <- result:
+		case <-d.done:
+		}
+	}()
+}
+
+// Shows the panes of the last load. Values selected while it was running stay selected.
+func (d *dashboard) apply(result loaded) {
+	if result.generation != d.generation {
+		return
+	}
+	d.loading = false
+	ids := [paneCount]string{}
+	for p := tenantsPane; p < paneCount; p++ {
+		ids[p] = d.selectedId(p)
+	}
+	d.panes = result.panes
+	for p := tenantsPane; p < paneCount; p++ {
+		d.setList(p, d.lists[p], ids[p])
+	}
+	if result.err != nil {
+		d.fail(result.err)
+	}
+}
+
+func (ps *panes) loadTenants(cfg *clicfg.Config, tenantId string) error {
+	tenants, err := api.ListTenants(cfg)
+	if err != nil {
+		return err
+	}
+	ps.setList(tenantsPane, tenants, tenantId)
+	return ps.loadInstances(cfg, ps.selectedId(instancesPane))
+}
+
+func (ps *panes) loadInstances(cfg *clicfg.Config, instanceId string) error {
+	ps.refreshed = time.Now()
+	tenantId := ps.selectedId(tenantsPane)
+	if tenantId == "" {
+		ps.setList(instancesPane, nil, "")
+		return ps.loadInstance(cfg)
+	}
+	instances, err := api.ListInstances(cfg, tenantId)
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(instances, func(a map[string]any, b map[string]any) int {
+		return compareText(a["name"], b["name"])
+	})
+	ps.setList(instancesPane, instances, instanceId)
+	return ps.loadInstance(cfg)
+}
+
+// Fetches the details, the snapshots and the Data APIs of the selected instance
+func (ps *panes) loadInstance(cfg *clicfg.Config) error {
+	instanceId := ps.selectedId(instancesPane)
+	snapshotId := ps.selectedId(snapshotsPane)
+	dataApiId := ps.selectedId(dataApisPane)
+	ps.detail = nil
+	ps.setList(snapshotsPane, nil, "")
+	ps.setList(dataApisPane, nil, "")
+	if instanceId == "" {
+		return nil
+	}
+
+	detail, err := api.GetInstance(cfg, instanceId)
+	if err != nil {
+		return err
+	}
+	ps.detail = detail
+
+	snapshots, err := api.ListSnapshots(cfg, instanceId, "")
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(snapshots, func(a map[string]any, b map[string]any) int {
+		return compareText(b["timestamp"], a["timestamp"])
+	})
+	ps.setList(snapshotsPane, snapshots, snapshotId)
+
+	// Data APIs are only available with the beta API
+	if !cfg.Aura.AuraBetaEnabled() {
+		return nil
+	}
+	dataApis, err := api.ListGraphQLDataApis(cfg, instanceId)
+	if err != nil {
+		return err
+	}
+	ps.setList(dataApisPane, dataApis, dataApiId)
+	return nil
+}
+
+// Sets the values of a pane, selecting the value with the ID if there is one, otherwise the first value
+func (ps *panes) setList(p pane, values []map[string]any, id string) {
+	ps.lists[p] = values
+	ps.selected[p] = max(0, slices.IndexFunc(values, func(value map[string]any) bool {
+		return id != "" && value[paneIdFields[p]] == id
+	}))
+}
+
+func (ps *panes) selectedValue(p pane) map[string]any {
+	if ps.selected[p] >= len(ps.lists[p]) {
+		return nil
+	}
+	return ps.lists[p][ps.selected[p]]
+}
+
+func (ps *panes) selectedId(p pane) string {
+	id, _ := ps.selectedValue(p)[paneIdFields[p]].(string)
+	return id
+}
+
+func (d *dashboard) fail(err error) {
+	d.message = fmt.Sprintf("Error: %s", err)
+}
+
+// Handles a key, returning true when the dashboard should be closed
+func (d *dashboard) handleKey(key string) bool {
+	if d.pending != nil {
+		pending := d.pending
+		d.pending = nil
+		if key != "y" {
+			d.message = "Cancelled"
+			return key == keyCtrlC
+		}
+		message, err := pending.run()
+		d.refresh()
+		if err != nil {
+			d.fail(err)
+		} else {
+			d.message = message
+		}
+		return false
+	}
+
+	switch key {
+	case "q", keyCtrlC:
+		return true
+	case keyUp, "k":
+		d.move(-1)
+	case keyDown, "j":
+		d.move(1)
+	case keyTab, keyRight, "l":
+		d.focus = (d.focus + 1) % paneCount
+	case keyLeft, "h":
+		d.focus = (d.focus + paneCount - 1) % paneCount
+	case " ":
+		d.refresh()
+	case "p":
+		d.confirm("Pause", fmt.Sprintf("/instances/%s/pause", d.selectedId(instancesPane)), http.MethodPost, "pausing")
+	case "r":
+		d.confirm("Resume", fmt.Sprintf("/instances/%s/resume", d.selectedId(instancesPane)), http.MethodPost, "resuming")
+	case "s":
+		d.confirm("Take a snapshot of", fmt.Sprintf("/instances/%s/snapshots", d.selectedId(instancesPane)), http.MethodPost, "taking a snapshot of")
+	case "d":
+		d.confirm("Delete", fmt.Sprintf("/instances/%s", d.selectedId(instancesPane)), http.MethodDelete, "deleting")
+	}
+	return false
+}
+
+// Moves the selection of the focused pane, loading the values that depend on it
+func (d *dashboard) move(offset int) {
+	p := d.focus
+	if len(d.lists[p]) == 0 {
+		return
+	}
+	selected := min(max(d.selected[p]+offset, 0), len(d.lists[p])-1)
+	if selected == d.selected[p] {
+		return
+	}
+	d.selected[p] = selected
+	switch p {
+	case tenantsPane:
+		d.setList(instancesPane, nil, "")
+		d.detail = nil
+		d.load(func(ps *panes) error {
+			return ps.loadInstances(d.cfg, "")
+		})
+	case instancesPane:
+		d.detail = nil
+		d.load(func(ps *panes) error {
+			return ps.loadInstance(d.cfg)
+		})
+	}
+}
+
+// Asks to confirm a request on the selected instance
+func (d *dashboard) confirm(verb string, path string, method string, progress string) {
+	instance := d.selectedValue(instancesPane)
+	if instance == nil {
+		d.message = "No instance is selected"
+		return
+	}
+	label := fmt.Sprintf("%s (%s)", instance["name"], instance["id"])
+	d.pending = &action{
+		prompt: fmt.Sprintf("%s instance %s? Press y to confirm, any other key to cancel", verb, label),
+		run: func() (string, error) {
+			if _, _, err := api.MakeRequest(d.cfg, path, &api.RequestConfig{Method: method}); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Started %s instance %s", progress, label), nil
+		},
+	}
+}
+
+func compareText(a any, b any) int {
+	textA, _ := a.(string)
+	textB, _ := b.(string)
+	return strings.Compare(textA, textB)
+}
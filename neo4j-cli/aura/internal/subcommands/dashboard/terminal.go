@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/term"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+// The size the dashboard is drawn with when the output is not a terminal
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// The size of the terminal, or the size given by the COLUMNS and LINES environment variables
+func screenSize(out *os.File) (int, int) {
+	width, height := 0, 0
+	if out != nil && output.IsTerminal(out) {
+		width, height, _ = term.GetSize(int(out.Fd()))
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		width = columns
+	}
+	if lines, err := strconv.Atoi(os.Getenv("LINES")); err == nil && lines > 0 {
+		height = lines
+	}
+	if width <= 0 {
+		width = defaultWidth
+	}
+	if height <= 0 {
+		height = defaultHeight
+	}
+	return width, height
+}
@@ -0,0 +1,121 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+const (
+	// Moves the cursor to the top left and clears the screen
+	clearScreen = "\033[H\033[2J"
+	// Switches to the alternate screen and hides the cursor, so that the terminal is restored on exit
+	enterScreen = "\033[?1049h\033[?25l"
+	leaveScreen = "\033[?25h\033[?1049l"
+	reverse     = "\033[7m"
+	reset       = "\033[0m"
+)
+
+const keyBindings = "↑↓ select  tab next pane  p pause  r resume  s snapshot  d delete  space refresh  q quit"
+
+// The fields of the detail pane, in the order of instance get
+var detailFields = []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id", "metrics_integration_url"}
+
+// Draws the dashboard as lines of the width and height: a pane per resource on the left,
+// the details of the selected instance on the right, then the message and the key bindings
+func (d *dashboard) render(width int, height int) string {
+	lines := []string{}
+	title := "Aura dashboard"
+	if tenant := d.selectedValue(tenantsPane); tenant != nil {
+		title += fmt.Sprintf(" - %s (%s)", tenant["name"], tenant["id"])
+	}
+	if !d.refreshed.IsZero() {
+		title += fmt.Sprintf(" - refreshed at %s", d.refreshed.Format("15:04:05"))
+	}
+	lines = append(lines, fit(title, width), "")
+
+	bodyHeight := max(height-4, int(paneCount)*2)
+	leftWidth := min(40, width/2)
+	left := []string{}
+	for p := tenantsPane; p < paneCount; p++ {
+		left = append(left, d.renderPane(p, leftWidth, bodyHeight/int(paneCount))...)
+	}
+	right := d.renderDetail(width - leftWidth - 3)
+	for i := 0; i < bodyHeight; i++ {
+		lines = append(lines, fit(line(left, i), leftWidth)+" │ "+fit(line(right, i), width-leftWidth-3))
+	}
+
+	message := d.message
+	if d.pending != nil {
+		message = d.pending.prompt
+	}
+	lines = append(lines, fit(message, width), fit(keyBindings, width))
+	return strings.Join(lines, "\r\n")
+}
+
+// Draws the title of a pane then its values, scrolled so that the selected value is visible
+func (d *dashboard) renderPane(p pane, width int, height int) []string {
+	title := fmt.Sprintf("%s (%d)", paneTitles[p], len(d.lists[p]))
+	if p == d.focus {
+		title = reverse + fit(title, width) + reset
+	}
+	lines := []string{title}
+	rows := max(height-1, 1)
+	first := max(0, d.selected[p]-rows+1)
+	for i := first; i < len(d.lists[p]) && i < first+rows; i++ {
+		marker := "  "
+		if i == d.selected[p] {
+			marker = "> "
+		}
+		lines = append(lines, marker+paneLabel(p, d.lists[p][i]))
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+func paneLabel(p pane, value map[string]any) string {
+	switch p {
+	case tenantsPane:
+		return fmt.Sprint(value["name"])
+	case instancesPane:
+		// Lists have no status, which is only fetched for the selected instance
+		return fmt.Sprint(value["name"])
+	case snapshotsPane:
+		return fmt.Sprintf("%v %v %v", value["timestamp"], value["profile"], value["status"])
+	default:
+		return fmt.Sprintf("%v %v", value["name"], value["status"])
+	}
+}
+
+func (d *dashboard) renderDetail(width int) []string {
+	instance := d.selectedValue(instancesPane)
+	if instance == nil {
+		return []string{"No instance selected"}
+	}
+	detail := d.detail
+	if detail == nil || detail["id"] != instance["id"] {
+		return []string{"Instance details", "Loading…"}
+	}
+	lines := []string{"Instance details"}
+	for _, field := range detailFields {
+		if value, ok := detail[field]; ok && value != nil && value != "" {
+			lines = append(lines, fmt.Sprintf("%-24s%v", field, value))
+		}
+	}
+	return lines
+}
+
+func line(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
+
+// Truncates or pads the text to the width
+func fit(value string, width int) string {
+	return text.Pad(text.Snip(value, width, "…"), width, ' ')
+}
@@ -1,9 +1,13 @@
 package dataapi
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/spf13/cobra"
 
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/dataapi/graphql"
 )
 
@@ -14,6 +18,9 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
 			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
 			return nil
@@ -24,7 +31,8 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 	cmd.PersistentFlags().String("auth-url", "", "")
 	cmd.PersistentFlags().String("base-url", "", "")
-	cmd.PersistentFlags().String("output", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
 
 	return cmd
 }
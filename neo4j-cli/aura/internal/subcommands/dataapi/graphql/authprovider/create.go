@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -13,25 +14,27 @@ import (
 
 func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 	const (
-		instanceIdFlag = "instance-id"
-		dataApiIdFlag  = "data-api-id"
-		typeFlag       = "type"
-		nameFlag       = "name"
-		enabledFlag    = "enabled"
-		urlFlag        = "url"
-		awaitFlag      = "await"
+		instanceIdFlag  = "instance-id"
+		dataApiIdFlag   = "data-api-id"
+		typeFlag        = "type"
+		nameFlag        = "name"
+		enabledFlag     = "enabled"
+		urlFlag         = "url"
+		ifNotExistsFlag = "if-not-exists"
+		awaitFlag       = "await"
 
 		enabledDefault = false
 	)
 
 	var (
-		instanceId string
-		dataApiId  string
-		_type      flags.AuthProviderType
-		name       string
-		enabled    bool
-		url        string
-		await      bool
+		instanceId  string
+		dataApiId   string
+		_type       flags.AuthProviderType
+		name        string
+		enabled     bool
+		url         string
+		ifNotExists bool
+		await       bool
 	)
 
 	cmd := &cobra.Command{
@@ -43,7 +46,9 @@ Creating a GraphQL Data API authentication provider is an asynchronous operation
 
 If you create an 'api-key' Authentication provider, an API key will be created. It is important to store the API key as it is not currently possible to get it or update it.
 
-If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.`,
+If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.
+
+With --if-not-exists, an existing Authentication provider with the same name on the GraphQL Data API is returned instead of creating a new one, which makes it safe to retry the command. If the create request gets no response, it is retried unless the Authentication provider is found to have been created. API keys are only returned for a new Authentication provider.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if _type == api.GraphQLDataApiAuthProviderTypeJwks {
 				cmd.MarkFlagRequired(urlFlag)
@@ -67,11 +72,31 @@ If you lose your API key, you will need to create a new Authentication provider.
 			}
 
 			cmd.SilenceUsage = true
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err = api.ResolveGraphQLDataApiId(cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId)
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
-				PostBody: body,
-				Method:   http.MethodPost,
-			})
+			var (
+				resBody    []byte
+				statusCode int
+				created    = true
+			)
+			if ifNotExists {
+				resBody, statusCode, created, err = api.CreateIfNotExists(cfg, path, body, func() (map[string]any, error) {
+					return api.FindAuthProviderByName(cfg, instanceId, dataApiId, name)
+				})
+			} else {
+				resBody, statusCode, err = api.MakeRequest(cfg, path, &api.RequestConfig{
+					PostBody: body,
+					Method:   http.MethodPost,
+				})
+			}
 			if err != nil {
 				return err
 			}
@@ -79,13 +104,17 @@ If you lose your API key, you will need to create a new Authentication provider.
 			// NOTE: Auth provider create should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 
-				if _type == api.GraphQLDataApiAuthProviderTypeApiKey {
+				if !created {
+					cmd.Printf("An Authentication provider named %s already exists, no new Authentication provider has been created\n", name)
+				} else if _type == api.GraphQLDataApiAuthProviderTypeApiKey {
 					cmd.Println("###############################")
 					cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
 					cmd.Println("###############################")
 				}
 
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "key", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "key", "url"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
@@ -102,9 +131,11 @@ If you lose your API key, you will need to create a new Authentication provider.
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to create the GraphQL Data API for")
+	completion.Flag(cmd, cfg, instanceIdFlag, completion.Instance)
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&dataApiId, dataApiIdFlag, "", "(required) The ID of the GraphQL Data API to create the authentication provider for")
+	completion.Flag(cmd, cfg, dataApiIdFlag, completion.GraphQLDataApi)
 	cmd.MarkFlagRequired(dataApiIdFlag)
 
 	msgTypeFlag := fmt.Sprintf("(required) The type of the Authentication provider, one of '%s' or '%s'", api.GraphQLDataApiAuthProviderTypeApiKey, api.GraphQLDataApiAuthProviderTypeJwks)
@@ -119,6 +150,8 @@ If you lose your API key, you will need to create a new Authentication provider.
 	msgUrlFlag := fmt.Sprintf("The JWKS URL that you want the bearer tokens in incoming GraphQL requests to be validated against. NOTE: only applicable for Authentication provider type '%s'", api.GraphQLDataApiAuthProviderTypeJwks)
 	cmd.Flags().StringVar(&url, urlFlag, "", msgUrlFlag)
 
+	cmd.Flags().BoolVar(&ifNotExists, ifNotExistsFlag, false, "Returns the existing Authentication provider with the same name on the GraphQL Data API instead of creating a new one")
+
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created GraphQL Data API is ready.")
 
 	return cmd
@@ -183,3 +183,45 @@ func TestCreateAuthProviderWithResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAuthProviderIfNotExistsWithExistingProvider(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	instanceId := "2f49c2b3"
+	dataApiId := "23ea345a"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId), http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794-e40e-41f7-8e8c-4a5b3f6a2c4d",
+				"name": "my-key-1",
+				"type": "api-key",
+				"enabled": true
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/data-apis/graphql/%s/auth-providers/1ad1b794-e40e-41f7-8e8c-4a5b3f6a2c4d", instanceId, dataApiId), http.StatusOK, `{
+		"data": {
+			"id": "1ad1b794-e40e-41f7-8e8c-4a5b3f6a2c4d",
+			"name": "my-key-1",
+			"type": "api-key",
+			"enabled": true
+		}
+	}`)
+	createMock := helper.NewRequestHandlerMock(fmt.Sprintf("POST /v1/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId), http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --name my-key-1 --type api-key --enabled --if-not-exists --output table", instanceId, dataApiId))
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut(`An Authentication provider named my-key-1 already exists, no new Authentication provider has been created
+┌──────────────────────────────────────┬──────────┬─────────┬─────────┬─────┬─────┐
+│ ID                                   │ NAME     │ TYPE    │ ENABLED │ KEY │ URL │
+├──────────────────────────────────────┼──────────┼─────────┼─────────┼─────┼─────┤
+│ 1ad1b794-e40e-41f7-8e8c-4a5b3f6a2c4d │ my-key-1 │ api-key │ true    │     │     │
+└──────────────────────────────────────┴──────────┴─────────┴─────────┴─────┴─────┘
+`)
+}
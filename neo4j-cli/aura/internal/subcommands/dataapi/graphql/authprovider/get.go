@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,7 +24,20 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, dataApiId, args[0])
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err = api.ResolveGraphQLDataApiId(cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
+			authProviderId, err := api.ResolveAuthProviderId(cfg, instanceId, dataApiId, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, dataApiId, authProviderId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{Method: http.MethodGet})
 			if err != nil {
@@ -31,17 +45,25 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance the GraphQL Data API is connected to")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().StringVar(&dataApiId, "data-api-id", "", "The ID of the GraphQL Data API to get the authentication provider of")
+	completion.Flag(cmd, cfg, "data-api-id", completion.GraphQLDataApi)
 	cmd.MarkFlagRequired("data-api-id")
 
+	output.AddWatchFlags(cmd)
+
+	completion.Arg(cmd, cfg, completion.AuthProvider)
+
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,15 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Returns a list of authentication providers of a specific GraphQL Data API",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err = api.ResolveGraphQLDataApiId(cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{Method: http.MethodGet})
@@ -29,17 +39,23 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance the GraphQL Data API is connected to")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().StringVar(&dataApiId, "data-api-id", "", "The ID of the GraphQL Data API to list the authentication providers of")
+	completion.Flag(cmd, cfg, "data-api-id", completion.GraphQLDataApi)
 	cmd.MarkFlagRequired("data-api-id")
 
+	output.AddWatchFlags(cmd)
+
 	return cmd
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		instancePasswordFlag = "instance-password"
 		typeDefsFlag         = "type-definitions"
 		typeDefsFileFlag     = "type-definitions-file"
+		ifNotExistsFlag      = "if-not-exists"
 		awaitFlag            = "await"
 	)
 
@@ -29,6 +31,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		instancePassword string
 		typeDefs         string
 		typeDefsFile     string
+		ifNotExists      bool
 		await            bool
 	)
 
@@ -41,7 +44,9 @@ Creating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 
 This command returns your GraphQL Data API ID, API key, and connection URL for you to use once the GraphQL Data API is running. It is important to store the API key as it is not currently possible to get this or update it.
 
-If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.`,
+If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.
+
+With --if-not-exists, an existing GraphQL Data API with the same name on the instance is returned instead of creating a new one, which makes it safe to retry the command. If the create request gets no response, it is retried unless the GraphQL Data API is found to have been created. API keys are only returned for a new GraphQL Data API.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			body := map[string]any{
 				"name": name,
@@ -67,11 +72,26 @@ If you lose your API key, you will need to create a new Authentication provider.
 			body["type_definitions"] = typeDefsForBody
 
 			cmd.SilenceUsage = true
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId)
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
-				PostBody: body,
-				Method:   http.MethodPost,
-			})
+			var (
+				resBody    []byte
+				statusCode int
+				created    = true
+			)
+			if ifNotExists {
+				resBody, statusCode, created, err = api.CreateIfNotExists(cfg, path, body, func() (map[string]any, error) {
+					return api.FindGraphQLDataApiByName(cfg, instanceId, name)
+				})
+			} else {
+				resBody, statusCode, err = api.MakeRequest(cfg, path, &api.RequestConfig{
+					PostBody: body,
+					Method:   http.MethodPost,
+				})
+			}
 			if err != nil {
 				return err
 			}
@@ -79,11 +99,17 @@ If you lose your API key, you will need to create a new Authentication provider.
 			// NOTE: GraphQL Data API create should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 
-				cmd.Println("###############################")
-				cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
-				cmd.Println("###############################")
+				if created {
+					cmd.Println("###############################")
+					cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
+					cmd.Println("###############################")
+				} else {
+					cmd.Printf("A GraphQL Data API named %s already exists, no new GraphQL Data API has been created\n", name)
+				}
 
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url", "authentication_providers"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url", "authentication_providers"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
@@ -105,6 +131,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to create the GraphQL Data API for")
+	completion.Flag(cmd, cfg, instanceIdFlag, completion.Instance)
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&instanceUsername, instanceUsernameFlag, "", "(required) The username of the instance this GraphQL Data API will be connected to")
@@ -122,6 +149,8 @@ If you lose your API key, you will need to create a new Authentication provider.
 	cmd.MarkFlagsMutuallyExclusive(typeDefsFlag, typeDefsFileFlag)
 	cmd.MarkFlagsOneRequired(typeDefsFlag, typeDefsFileFlag)
 
+	cmd.Flags().BoolVar(&ifNotExists, ifNotExistsFlag, false, "Returns the existing GraphQL Data API with the same name on the instance instead of creating a new one")
+
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created GraphQL Data API is ready.")
 
 	return cmd
@@ -117,19 +117,11 @@ func TestCreateGraphQLDataApiWithResponse(t *testing.T) {
 	expectedResponseTable := `###############################
 # It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.
 ###############################
-┌──────────┬───────────────┬──────────┬────────────────────────────────────────────────────────────────────────────────┬───────────────────────────────────────────────────┐
-│ ID       │ NAME          │ STATUS   │ URL                                                                            │ AUTHENTICATION_PROVIDERS                          │
-├──────────┼───────────────┼──────────┼────────────────────────────────────────────────────────────────────────────────┼───────────────────────────────────────────────────┤
-│ 2f49c2b3 │ my-data-api-1 │ creating │ https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql │ [                                                 │
-│          │               │          │                                                                                │   {                                               │
-│          │               │          │                                                                                │     "enabled": true,                              │
-│          │               │          │                                                                                │     "id": "1ad1b794-e40e-41f7-8e8c-5638130317ed", │
-│          │               │          │                                                                                │     "key": "ublHwKxm2ylsc1HlkuL8NAcMfZnEVP1g",    │
-│          │               │          │                                                                                │     "name": "default",                            │
-│          │               │          │                                                                                │     "type": "api-key"                             │
-│          │               │          │                                                                                │   }                                               │
-│          │               │          │                                                                                │ ]                                                 │
-└──────────┴───────────────┴──────────┴────────────────────────────────────────────────────────────────────────────────┴───────────────────────────────────────────────────┘
+┌──────────┬───────────────┬──────────┬────────────────────────────────────────────────────────────────────────────────┬─────────────────────────────────────────────────────────────────────────────────────────────────────────────────────┐
+│ ID       │ NAME          │ STATUS   │ URL                                                                            │ AUTHENTICATION_PROVIDERS                                                                                            │
+├──────────┼───────────────┼──────────┼────────────────────────────────────────────────────────────────────────────────┼─────────────────────────────────────────────────────────────────────────────────────────────────────────────────────┤
+│ 2f49c2b3 │ my-data-api-1 │ creating │ https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql │ enabled=true id=1ad1b794-e40e-41f7-8e8c-5638130317ed key=ublHwKxm2ylsc1HlkuL8NAcMfZnEVP1g name=default type=api-key │
+└──────────┴───────────────┴──────────┴────────────────────────────────────────────────────────────────────────────────┴─────────────────────────────────────────────────────────────────────────────────────────────────────────────────────┘
 	`
 
 	tests := map[string]struct {
@@ -170,3 +162,44 @@ func TestCreateGraphQLDataApiWithResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateGraphQLDataApiIfNotExistsWithExistingDataApi(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	instanceId := "2f49c2b3"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/data-apis/graphql", instanceId), http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "my-data-api-1",
+				"status": "ready",
+				"url": "https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/data-apis/graphql/2f49c2b3", instanceId), http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "my-data-api-1",
+			"status": "ready",
+			"url": "https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql"
+		}
+	}`)
+	createMock := helper.NewRequestHandlerMock(fmt.Sprintf("POST /v1/instances/%s/data-apis/graphql", instanceId), http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("data-api graphql create --instance-id %s --instance-username neo4j --instance-password dfjglhssdopfrow --name my-data-api-1 --type-definitions dHlwZSBNb3ZpZSB7CiAgdGl0bGU6IFN0cmluZwkKfQ== --if-not-exists --output table", instanceId))
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut(`A GraphQL Data API named my-data-api-1 already exists, no new GraphQL Data API has been created
+┌──────────┬───────────────┬────────┬────────────────────────────────────────────────────────────────────────────────┬──────────────────────────┐
+│ ID       │ NAME          │ STATUS │ URL                                                                            │ AUTHENTICATION_PROVIDERS │
+├──────────┼───────────────┼────────┼────────────────────────────────────────────────────────────────────────────────┼──────────────────────────┤
+│ 2f49c2b3 │ my-data-api-1 │ ready  │ https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql │                          │
+└──────────┴───────────────┴────────┴────────────────────────────────────────────────────────────────────────────────┴──────────────────────────┘
+`)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -20,7 +21,16 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, args[0])
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := api.ResolveGraphQLDataApiId(cfg, instanceId, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
@@ -31,14 +41,19 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 
 			// NOTE: delete should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance to delete the Data API for")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
+	completion.Arg(cmd, cfg, completion.GraphQLDataApi)
+
 	return cmd
 }
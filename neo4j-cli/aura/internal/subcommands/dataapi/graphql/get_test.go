@@ -107,3 +107,52 @@ func TestGetGraphQLDataApiIncludingGraphQLServerErrors(t *testing.T) {
 		]
 	}`)
 }
+
+func TestGetGraphQLDataApiByInstanceAndDataApiName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "afdb4e9d",
+				"name": "friendly-name",
+				"status": "ready",
+				"url": "https://afdb4e9d.28be6e4d8d3e836019.graphql.neo4j.io/graphql"
+			}
+		]
+	}`)
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "friendly-name",
+			"status": "ready",
+			"url": "https://afdb4e9d.28be6e4d8d3e836019.graphql.neo4j.io/graphql"
+		}
+	}`)
+
+	helper.ExecuteCommand("data-api graphql get --output json --instance-id name:Production name:friendly-name")
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "friendly-name",
+			"status": "ready",
+			"url": "https://afdb4e9d.28be6e4d8d3e836019.graphql.neo4j.io/graphql"
+		}
+	}`)
+}
@@ -6,18 +6,30 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
-	var instanceId string
+	var (
+		instanceId  string
+		listOptions output.ListOptions
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of GraphQL Data APIs",
+		Long: `This subcommand returns a list of the GraphQL Data APIs of an instance.
+
+The listed Data APIs can be filtered with --filter, such as --filter 'status=ready', and sorted with --sort, such as --sort name.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{Method: http.MethodGet})
 			if err != nil {
@@ -25,14 +37,21 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.ParseBody(resBody)), []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance to list the GraphQL Data APIs of")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
+	output.AddListFlags(cmd, &listOptions)
+
+	output.AddWatchFlags(cmd)
+
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -25,7 +26,16 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", instanceId, args[0])
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := api.ResolveGraphQLDataApiId(cfg, instanceId, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", instanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
@@ -36,11 +46,13 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 
 			// NOTE: pause should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be paused...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusPausing)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, dataApiId, api.GraphQLDataApiStatusPausing)
 					if err != nil {
 						return err
 					}
@@ -53,9 +65,12 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance to pause the Data API for")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until GraphQL Data API is paused.")
 
+	completion.Arg(cmd, cfg, completion.GraphQLDataApi)
+
 	return cmd
 }
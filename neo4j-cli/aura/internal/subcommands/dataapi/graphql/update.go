@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 		typeDefsFlag         = "type-definitions"
 		typeDefsFileFlag     = "type-definitions-file"
 		awaitFlag            = "await"
+		dryRunFlag           = "dry-run"
 	)
 
 	var (
@@ -29,6 +31,7 @@ func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 		typeDefs         string
 		typeDefsFile     string
 		await            bool
+		dryRun           bool
 	)
 
 	cmd := &cobra.Command{
@@ -36,7 +39,9 @@ func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Edit a GraphQL Data API",
 		Long: `This endpoint edits a specific GraphQL Data API.
 		
-Updating a GraphQL Data API is an asynchronous operation. Use the --await flag to wait for the GraphQL Data API to be ready again. Once the status transitions from "updating" to "ready" you may continue to use your GraphQL Data API.`,
+Updating a GraphQL Data API is an asynchronous operation. Use the --await flag to wait for the GraphQL Data API to be ready again. Once the status transitions from "updating" to "ready" you may continue to use your GraphQL Data API.
+
+Use --dry-run to print the request that would be sent along with the fields it would change, without updating the GraphQL Data API.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			body := map[string]any{}
@@ -67,7 +72,36 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 			}
 
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, args[0])
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := api.ResolveGraphQLDataApiId(cfg, instanceId, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, dataApiId)
+
+			if dryRun {
+				resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+					Method: http.MethodGet,
+				})
+				if err != nil {
+					return err
+				}
+
+				if statusCode == http.StatusOK {
+					current, err := api.ParseBody(resBody).GetSingleOrError()
+					if err != nil {
+						return err
+					}
+					if err := output.PrintDryRun(cmd, cfg, http.MethodPatch, path, body, current); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:   http.MethodPatch,
@@ -79,11 +113,13 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 
 			// NOTE: GraphQL Data API update should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be updated...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusUpdating)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, dataApiId, api.GraphQLDataApiStatusUpdating)
 					if err != nil {
 						return err
 					}
@@ -96,6 +132,7 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to update the Data API for")
+	completion.Flag(cmd, cfg, instanceIdFlag, completion.Instance)
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&name, nameFlag, "", "The name of the GraphQL Data API")
@@ -111,5 +148,9 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until updated GraphQL Data API is ready again.")
 
+	cmd.Flags().BoolVar(&dryRun, dryRunFlag, false, "Prints the request and the resulting changes without updating the GraphQL Data API.")
+
+	completion.Arg(cmd, cfg, completion.GraphQLDataApi)
+
 	return cmd
 }
@@ -122,3 +122,52 @@ func TestUpdateGraphQLDataApiWithResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGraphQLDataApiDryRun(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	instanceId := "2f49c2b3"
+	dataApiId := "afdb4e9d"
+
+	getMock := helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/data-apis/graphql/%s", instanceId, dataApiId), http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "friendly-name-4",
+			"status": "ready",
+			"type_definitions": "dHlwZS=="
+		}
+	}`)
+	patchMock := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s/data-apis/graphql/%s", instanceId, dataApiId), http.StatusAccepted, "")
+
+	helper.ExecuteCommand(fmt.Sprintf("data-api graphql update --output json --instance-id %s --name my-data-api-2 --instance-username neo4j --type-definitions dHlwZS== --dry-run %s", instanceId, dataApiId))
+
+	getMock.AssertCalledTimes(1)
+	patchMock.AssertCalledTimes(0)
+
+	helper.AssertOutJson(`{
+		"method": "PATCH",
+		"path": "/instances/2f49c2b3/data-apis/graphql/afdb4e9d",
+		"body": {
+			"aura_instance": {
+				"username": "neo4j"
+			},
+			"name": "my-data-api-2",
+			"type_definitions": "dHlwZS=="
+		},
+		"diff": [
+			{
+				"after": "neo4j",
+				"before": null,
+				"field": "aura_instance.username"
+			},
+			{
+				"after": "my-data-api-2",
+				"before": "friendly-name-4",
+				"field": "name"
+			}
+		]
+	}`)
+}
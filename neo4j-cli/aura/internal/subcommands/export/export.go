@@ -0,0 +1,113 @@
+package export
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/declarative"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		tenantId string
+		dir      string
+		format   string
+	)
+
+	const (
+		tenantIdFlag = "tenant-id"
+		dirFlag      = "dir"
+		formatFlag   = "format"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the live resources of a tenant into spec files",
+		Long: `This command writes the live resources of a tenant into a directory of spec files, one file per resource, that can be committed to git and used with the plan and apply commands:
+
+	customer-managed-keys/<name>.yaml
+	instances/<name>.yaml
+	instances/<name>/snapshots/<snapshot id>.yaml
+	instances/<name>/graphql-data-apis/<name>.yaml
+	instances/<name>/graphql-data-apis/<name>.graphql
+
+Snapshots of the current day are exported for reference only, they are not managed by the apply command. GraphQL Data APIs are only exported when the beta is enabled, with their type definitions decoded into a .graphql file.
+
+Secrets are never exported. Instance credentials must be added to GraphQL Data API specs before they can be recreated, for example with instance_password: ${INSTANCE_PASSWORD}.
+
+If no tenant ID is provided, the default tenant is used.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
+
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(declarative.ValidExportFormats, format) {
+				return fmt.Errorf(`invalid argument "%s" for "--%s" flag: must be one of "%s"`, format, formatFlag, strings.Join(declarative.ValidExportFormats, `" or "`))
+			}
+
+			if cfg.Aura.DefaultTenant() == "" {
+				cmd.MarkFlagRequired(tenantIdFlag)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if tenantId == "" {
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+			var err error
+			tenantId, err = api.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
+			}
+
+			files, err := declarative.Export(cfg, tenantId, dir, format)
+			if err != nil {
+				return err
+			}
+
+			rows := []map[string]any{}
+			for _, file := range files {
+				rows = append(rows, map[string]any{"kind": file.Kind, "name": file.Name, "file": file.File})
+			}
+			if err := output.PrintBodyMap(cmd, cfg, api.NewResponseData(rows), []string{"kind", "name", "file"}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The ID of the tenant to export")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+
+	cmd.Flags().StringVar(&dir, dirFlag, "", "(required) Directory to write the spec files to")
+	cmd.MarkFlagRequired(dirFlag)
+
+	cmd.Flags().StringVar(&format, formatFlag, declarative.ExportFormatYaml, fmt.Sprintf("Format of the spec files, from a choice of [%s]", strings.Join(declarative.ValidExportFormats, ", ")))
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,213 @@
+package export_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func mockLiveResources(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{
+				"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+				"name": "production-key",
+				"tenant_id": "YOUR_TENANT_ID"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4", http.StatusOK, `{
+		"data": {
+			"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+			"name": "production-key",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "ready",
+			"cloud_provider": "aws",
+			"region": "eu-west-1",
+			"type": "enterprise-db",
+			"key_id": "arn:aws:kms:eu-west-1:123456789:key/abc"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "aws"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"connection_url": "neo4j+s://2f49c2b3.databases.neo4j.io",
+			"cloud_provider": "aws",
+			"region": "eu-west-1",
+			"type": "enterprise-db",
+			"memory": "8GB",
+			"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{
+		"data": [
+			{
+				"exportable": true,
+				"instance_id": "2f49c2b3",
+				"profile": "Scheduled",
+				"snapshot_id": "afdb4e9d-6ba6-4d45-b951-f82843dcbca6",
+				"status": "Completed",
+				"timestamp": "2026-10-19T01:00:00Z"
+			}
+		]
+	}`)
+}
+
+func TestExport(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockLiveResources(&helper)
+
+	helper.ExecuteCommand("export --tenant-id YOUR_TENANT_ID --dir /export")
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"file": "/export/customer-managed-keys/production-key.yaml",
+				"kind": "CustomerManagedKey",
+				"name": "production-key"
+			},
+			{
+				"file": "/export/instances/Production.yaml",
+				"kind": "Instance",
+				"name": "Production"
+			},
+			{
+				"file": "/export/instances/Production/snapshots/afdb4e9d-6ba6-4d45-b951-f82843dcbca6.yaml",
+				"kind": "Snapshot",
+				"name": "afdb4e9d-6ba6-4d45-b951-f82843dcbca6"
+			}
+		]
+	}`)
+
+	assert.Equal(t, `kind: CustomerManagedKey
+name: production-key
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: aws
+region: eu-west-1
+key_id: arn:aws:kms:eu-west-1:123456789:key/abc
+`, helper.ReadFile("/export/customer-managed-keys/production-key.yaml"))
+	assert.Equal(t, `kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: aws
+region: eu-west-1
+memory: 8GB
+customer_managed_key: production-key
+`, helper.ReadFile("/export/instances/Production.yaml"))
+	assert.Equal(t, `kind: Snapshot
+snapshot_id: afdb4e9d-6ba6-4d45-b951-f82843dcbca6
+instance: Production
+profile: Scheduled
+status: Completed
+timestamp: "2026-10-19T01:00:00Z"
+exportable: true
+`, helper.ReadFile("/export/instances/Production/snapshots/afdb4e9d-6ba6-4d45-b951-f82843dcbca6.yaml"))
+}
+
+func TestExportGraphQLDataApisAsJsonWithDefaultTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetConfigValue("aura.default-tenant", "YOUR_TENANT_ID")
+	mockLiveResources(&helper)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "e157301d",
+				"name": "movies api",
+				"status": "ready"
+			}
+		]
+	}`)
+	typeDefs := "type Movie {\n\ttitle: String\n}\n"
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/e157301d", http.StatusOK, `{
+		"data": {
+			"id": "e157301d",
+			"name": "movies api",
+			"status": "ready",
+			"url": "https://e157301d.1.graphql.neo4j-dev.io/graphql",
+			"type_definitions": "`+base64.StdEncoding.EncodeToString([]byte(typeDefs))+`"
+		}
+	}`)
+	authProvidersMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/e157301d/auth-providers", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794",
+				"name": "default",
+				"type": "api-key",
+				"enabled": true,
+				"key": "secret-key"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("export --dir /export --format json --output table")
+
+	authProvidersMock.AssertCalledTimes(1)
+	helper.AssertOut(`
+┌────────────────────┬──────────────────────────────────────┬──────────────────────────────────────────────────────────────────────────────────┐
+│ KIND               │ NAME                                 │ FILE                                                                             │
+├────────────────────┼──────────────────────────────────────┼──────────────────────────────────────────────────────────────────────────────────┤
+│ CustomerManagedKey │ production-key                       │ /export/customer-managed-keys/production-key.json                                │
+│ Instance           │ Production                           │ /export/instances/Production.json                                                │
+│ Snapshot           │ afdb4e9d-6ba6-4d45-b951-f82843dcbca6 │ /export/instances/Production/snapshots/afdb4e9d-6ba6-4d45-b951-f82843dcbca6.json │
+│ GraphQLDataApi     │ movies api                           │ /export/instances/Production/graphql-data-apis/movies-api.json                   │
+└────────────────────┴──────────────────────────────────────┴──────────────────────────────────────────────────────────────────────────────────┘
+`)
+
+	assert.Equal(t, typeDefs, helper.ReadFile("/export/instances/Production/graphql-data-apis/movies-api.graphql"))
+	assert.Equal(t, `{
+	"kind": "GraphQLDataApi",
+	"name": "movies api",
+	"instance": "Production",
+	"type_definitions_file": "movies-api.graphql",
+	"auth_providers": [
+		{
+			"name": "default",
+			"type": "api-key",
+			"enabled": true
+		}
+	]
+}
+`, helper.ReadFile("/export/instances/Production/graphql-data-apis/movies-api.json"))
+}
+
+func TestExportWithInvalidFormat(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("export --tenant-id YOUR_TENANT_ID --dir /export --format toml")
+
+	helper.AssertErr(`Error: invalid argument "toml" for "--format" flag: must be one of "yaml" or "json"`)
+}
+
+func TestExportWithoutTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("export --dir /export")
+
+	helper.AssertErr(`Error: required flag(s) "tenant-id" not set`)
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/graph"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		tenantIds []string
+		format    string
+		file      string
+	)
+
+	const (
+		tenantIdFlag = "tenant-id"
+		formatFlag   = "format"
+		fileFlag     = "file"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Outputs the relationships between your Aura resources as a graph",
+		Long: `This command builds the graph of your Aura resources and their relationships:
+
+	(Tenant)-[:HAS_INSTANCE]->(Instance)
+	(Tenant)-[:HAS_CUSTOMER_MANAGED_KEY]->(CustomerManagedKey)-[:ENCRYPTS]->(Instance)
+	(Instance)-[:HAS_GRAPHQL_DATA_API]->(GraphQLDataApi)-[:HAS_AUTH_PROVIDER]->(AuthProvider)
+
+GraphQL Data APIs and authentication providers are only included when the beta is enabled.
+
+The graph is output as a Graphviz DOT digraph, a Mermaid flowchart, or a Cypher script that recreates the topology in a Neo4j database. The Cypher script uses MERGE, so it can be run again to update the database.
+
+All tenants are included, unless one or more tenants are selected with --tenant-id. The graph is printed, unless --file is set.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(graph.ValidFormats, format) {
+				return fmt.Errorf(`invalid argument "%s" for "--%s" flag: must be one of "%s"`, format, formatFlag, strings.Join(graph.ValidFormats, `", "`))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			for i, tenantId := range tenantIds {
+				var err error
+				tenantIds[i], err = api.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+			}
+
+			g, err := graph.Build(cfg, tenantIds)
+			if err != nil {
+				return err
+			}
+
+			if file == "" {
+				return g.Write(cmd.OutOrStdout(), format)
+			}
+
+			var buffer bytes.Buffer
+			if err := g.Write(&buffer, format); err != nil {
+				return err
+			}
+			if err := afero.WriteFile(cfg.Aura.Fs(), file, buffer.Bytes(), 0644); err != nil {
+				return clierr.NewFatalError("unable to write %s: %w", file, err)
+			}
+			cmd.Printf("Graph of %d resources and %d relationships written to %s\n", len(g.Nodes), len(g.Relationships), file)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&tenantIds, tenantIdFlag, nil, "The IDs of the tenants to include, all tenants if not set")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+	cmd.Flags().StringVar(&format, formatFlag, graph.FormatDot, fmt.Sprintf("Format of the graph, from a choice of [%s]", strings.Join(graph.ValidFormats, ", ")))
+	cmd.Flags().StringVar(&file, fileFlag, "", "File to write the graph to")
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+
+	return cmd
+}
@@ -0,0 +1,145 @@
+package graph_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func mockResources(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{
+				"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+				"name": "production-key",
+				"tenant_id": "YOUR_TENANT_ID"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "orders",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "orders",
+			"status": "running",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4"
+		}
+	}`)
+}
+
+func TestGraphDot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockResources(&helper)
+
+	helper.ExecuteCommand("graph")
+
+	helper.AssertOut(`digraph aura {
+	rankdir=LR;
+	node [shape=box];
+	"tenant_YOUR_TENANT_ID" [label="Tenant\nProduction"];
+	"customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4" [label="CustomerManagedKey\nproduction-key"];
+	"instance_2f49c2b3" [label="Instance\norders"];
+	"tenant_YOUR_TENANT_ID" -> "customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4" [label="HAS_CUSTOMER_MANAGED_KEY"];
+	"tenant_YOUR_TENANT_ID" -> "instance_2f49c2b3" [label="HAS_INSTANCE"];
+	"customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4" -> "instance_2f49c2b3" [label="ENCRYPTS"];
+}
+`)
+}
+
+func TestGraphMermaidWithGraphQLDataApis(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	mockResources(&helper)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "7bc2a5d1",
+				"name": "orders-api",
+				"status": "ready",
+				"url": "https://7bc2a5d1.graphql.neo4j.io/graphql"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/7bc2a5d1/auth-providers", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794-e40e-41f7-8e8c-1e5d0c1b3b2a",
+				"name": "default",
+				"type": "api-key",
+				"enabled": true
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("graph --format mermaid")
+
+	helper.AssertOut(`flowchart LR
+	tenant_YOUR_TENANT_ID["Tenant: Production"]
+	customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4["CustomerManagedKey: production-key"]
+	instance_2f49c2b3["Instance: orders"]
+	graphqldataapi_7bc2a5d1["GraphQLDataApi: orders-api"]
+	authprovider_1ad1b794_e40e_41f7_8e8c_1e5d0c1b3b2a["AuthProvider: default"]
+	tenant_YOUR_TENANT_ID -->|HAS_CUSTOMER_MANAGED_KEY| customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4
+	tenant_YOUR_TENANT_ID -->|HAS_INSTANCE| instance_2f49c2b3
+	customermanagedkey_f15cfd42_2a32_4e94_9b5c_5b4ba1dec9a4 -->|ENCRYPTS| instance_2f49c2b3
+	instance_2f49c2b3 -->|HAS_GRAPHQL_DATA_API| graphqldataapi_7bc2a5d1
+	graphqldataapi_7bc2a5d1 -->|HAS_AUTH_PROVIDER| authprovider_1ad1b794_e40e_41f7_8e8c_1e5d0c1b3b2a
+`)
+}
+
+func TestGraphCypherToFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockResources(&helper)
+
+	helper.ExecuteCommand("graph --format cypher --file aura.cypher")
+
+	helper.AssertOut("Graph of 3 resources and 3 relationships written to aura.cypher\n")
+
+	assert.Equal(t, `MERGE (n:Tenant {id: 'YOUR_TENANT_ID'}) SET n.name = 'Production';
+MERGE (n:CustomerManagedKey {id: 'f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4'}) SET n.name = 'production-key';
+MERGE (n:Instance {id: '2f49c2b3'}) SET n.name = 'orders', n.cloud_provider = 'gcp', n.region = 'europe-west1', n.status = 'running', n.type = 'enterprise-db';
+MATCH (a:Tenant {id: 'YOUR_TENANT_ID'}), (b:CustomerManagedKey {id: 'f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4'}) MERGE (a)-[:HAS_CUSTOMER_MANAGED_KEY]->(b);
+MATCH (a:Tenant {id: 'YOUR_TENANT_ID'}), (b:Instance {id: '2f49c2b3'}) MERGE (a)-[:HAS_INSTANCE]->(b);
+MATCH (a:CustomerManagedKey {id: 'f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4'}), (b:Instance {id: '2f49c2b3'}) MERGE (a)-[:ENCRYPTS]->(b);
+`, helper.ReadFile("aura.cypher"))
+}
+
+func TestGraphWithInvalidFormat(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("graph --format svg")
+
+	helper.AssertErr(`Error: invalid argument "svg" for "--format" flag: must be one of "dot", "mermaid", "cypher"
+`)
+}
@@ -0,0 +1,210 @@
+package instance
+
+import (
+	"bufio"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+const (
+	allFlag         = "all"
+	tenantIdFlag    = "tenant-id"
+	nameGlobFlag    = "name-glob"
+	typeFlag        = "type"
+	statusFlag      = "status"
+	concurrencyFlag = "concurrency"
+	rateLimitFlag   = "rate-limit"
+
+	defaultConcurrency = 4
+	defaultRateLimit   = 5
+)
+
+// Selects several instances in place of an instance ID, and how requests for them are run
+type bulkOptions struct {
+	all         bool
+	tenantId    string
+	nameGlob    string
+	_type       flags.InstanceType
+	status      string
+	concurrency int
+	rateLimit   float64
+}
+
+const bulkHelp = `Instead of an instance ID, you can select several instances with --all, --tenant-id, --name-glob, --type and --status. Selectors are combined, so --tenant-id with --name-glob 'dev-*' selects the instances of the tenant with a name starting with dev-. Requests are sent concurrently, limited by --concurrency and --rate-limit, and a result is printed for every instance. Where the command has --await, instances are awaited as soon as their request is sent, without counting towards --concurrency. The command fails if any of the requests fails.`
+
+func addBulkFlags(cmd *cobra.Command, cfg *clicfg.Config, options *bulkOptions) {
+	cmd.Flags().BoolVar(&options.all, allFlag, false, "Selects all instances you have access to")
+	cmd.Flags().StringVar(&options.tenantId, tenantIdFlag, "", "Selects the instances of a tenant")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+	cmd.Flags().StringVar(&options.nameGlob, nameGlobFlag, "", "Selects the instances with a name matching a glob pattern, such as 'dev-*'")
+	cmd.Flags().Var(&options._type, typeFlag, "Selects the instances of a type")
+	cmd.Flags().StringVar(&options.status, statusFlag, "", "Selects the instances with a status, such as running or paused")
+	cmd.Flags().IntVar(&options.concurrency, concurrencyFlag, defaultConcurrency, "The maximum number of requests sent at once when several instances are selected")
+	cmd.Flags().Float64Var(&options.rateLimit, rateLimitFlag, defaultRateLimit, "The maximum number of requests sent per second when several instances are selected, 0 for no limit")
+}
+
+func (options *bulkOptions) isSet() bool {
+	return options.all || options.tenantId != "" || options.nameGlob != "" || options._type != "" || options.status != ""
+}
+
+// Checks that either an instance ID or selectors are given, but not both
+func (options *bulkOptions) validate(args []string) error {
+	if len(args) > 0 && options.isSet() {
+		return clierr.NewUsageError("an instance ID cannot be used together with the --%s, --%s, --%s, --%s or --%s flags", allFlag, tenantIdFlag, nameGlobFlag, typeFlag, statusFlag)
+	}
+	if len(args) == 0 && !options.isSet() {
+		return clierr.NewUsageError("requires an instance ID, or at least one of the --%s, --%s, --%s, --%s or --%s flags", allFlag, tenantIdFlag, nameGlobFlag, typeFlag, statusFlag)
+	}
+	if options.nameGlob != "" {
+		if _, err := path.Match(options.nameGlob, ""); err != nil {
+			return clierr.NewUsageError("invalid argument \"%s\" for \"--%s\" flag: %s", options.nameGlob, nameGlobFlag, err)
+		}
+	}
+	if options.concurrency < 1 {
+		return clierr.NewUsageError("invalid argument \"%d\" for \"--%s\" flag: must be at least 1", options.concurrency, concurrencyFlag)
+	}
+	if options.rateLimit < 0 {
+		return clierr.NewUsageError("invalid argument \"%v\" for \"--%s\" flag: must not be negative", options.rateLimit, rateLimitFlag)
+	}
+	return nil
+}
+
+// Lists the instances matching the selectors. Instance details are only fetched when selecting by type or status.
+func (options *bulkOptions) selectInstances(cfg *clicfg.Config) ([]map[string]any, error) {
+	tenantId := ""
+	if options.tenantId != "" {
+		var err error
+		tenantId, err = api.ResolveTenantId(cfg, options.tenantId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instances, err := api.ListInstances(cfg, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := []map[string]any{}
+	for _, instance := range instances {
+		name, _ := instance["name"].(string)
+		if matched, _ := path.Match(options.nameGlob, name); options.nameGlob == "" || matched {
+			selected = append(selected, instance)
+		}
+	}
+
+	if options._type == "" && options.status == "" {
+		return selected, nil
+	}
+
+	details := make([]map[string]any, len(selected))
+	errs := make([]error, len(selected))
+	api.RunConcurrently(len(selected), options.concurrency, options.rateLimit, func(i int) {
+		details[i], errs[i] = api.GetInstance(cfg, selected[i]["id"].(string))
+	})
+
+	filtered := []map[string]any{}
+	for i, instance := range details {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if options._type != "" && instance["type"] != options._type.String() {
+			continue
+		}
+		if options.status != "" && instance["status"] != options.status {
+			continue
+		}
+		filtered = append(filtered, instance)
+	}
+	return filtered, nil
+}
+
+// Asks for confirmation before acting on the selected instances, returning false if it is not given
+func confirmBulk(cmd *cobra.Command, instances []map[string]any, action string) bool {
+	cmd.Printf("The following %d instances will be %s:\n", len(instances), action)
+	for _, instance := range instances {
+		cmd.Printf("\t%s (%s)\n", instance["name"], instance["id"])
+	}
+	cmd.Print("Do you want to continue? Only 'yes' will be accepted: ")
+	answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	return strings.TrimSpace(answer) == "yes"
+}
+
+// Sends the request for every instance concurrently and prints the resulting status of each, once it is
+// no longer in the waiting status if awaited. Fails if any request failed.
+func runBulk(cmd *cobra.Command, cfg *clicfg.Config, options *bulkOptions, instances []map[string]any, request func(instanceId string) ([]byte, error), await bool, waitingStatus string) error {
+	if len(instances) == 0 {
+		cmd.Println("No instances match the selectors")
+		return nil
+	}
+
+	results := make([]map[string]any, len(instances))
+	var awaiting sync.WaitGroup
+	api.RunConcurrently(len(instances), options.concurrency, options.rateLimit, func(i int) {
+		id, _ := instances[i]["id"].(string)
+		result := map[string]any{"id": id, "name": instances[i]["name"], "status": "", "error": ""}
+		results[i] = result
+
+		resBody, err := request(id)
+		if err != nil {
+			result["error"] = err.Error()
+			return
+		}
+		// Instances are awaited outside of the concurrency limit, so that the requests for the next instances are not held back
+		awaiting.Add(1)
+		go func() {
+			defer awaiting.Done()
+			status, err := awaitStatus(cfg, id, resBody, await, waitingStatus)
+			if err != nil {
+				result["error"] = err.Error()
+			} else {
+				result["status"] = status
+			}
+		}()
+	})
+	awaiting.Wait()
+
+	if err := output.PrintBodyMap(cmd, cfg, api.NewResponseData(results), []string{"id", "name", "status", "error"}); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result["error"] != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d instances failed", failed, len(instances))
+	}
+	return nil
+}
+
+// The status of the instance in the response body, or once it is no longer in the waiting status if awaited
+func awaitStatus(cfg *clicfg.Config, instanceId string, resBody []byte, await bool, waitingStatus string) (string, error) {
+	if await {
+		pollResponse, err := api.PollInstance(cfg, instanceId, waitingStatus)
+		if err != nil {
+			return "", err
+		}
+		return pollResponse.Data.Status, nil
+	}
+
+	instance, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return "", err
+	}
+	status, _ := instance["status"].(string)
+	return status, nil
+}
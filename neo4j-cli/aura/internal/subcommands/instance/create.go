@@ -7,6 +7,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/cost"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -22,7 +24,12 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		tenantId             string
 		cloudProvider        flags.CloudProvider
 		customerManagedKeyId string
+		ifNotExists          bool
 		await                bool
+		estimate             bool
+		priceFile            string
+		interactive          bool
+		wizard               bool
 	)
 
 	const (
@@ -34,7 +41,11 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		tenantIdFlag             = "tenant-id"
 		cloudProviderFlag        = "cloud-provider"
 		customerManagedKeyIdFlag = "customer-managed-key-id"
+		ifNotExistsFlag          = "if-not-exists"
 		awaitFlag                = "await"
+		estimateFlag             = "estimate"
+		priceFileFlag            = "price-file"
+		interactiveFlag          = "interactive"
 	)
 
 	cmd := &cobra.Command{
@@ -42,7 +53,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Creates a new instance",
 		Long: `This subcommand starts the creation process of an Aura instance.
 
-Creating an instance is an asynchronous operation that can be awaited with --await. Supported instance configurations for your tenant can be obtained by calling the tenant get subcommand.
+Creating an instance is an asynchronous operation that can be awaited with --await. Supported instance configurations for your tenant can be obtained by calling the tenant get subcommand. The type, cloud provider, region and memory are checked against them before the instance is created, and the nearest valid regions and memory sizes are suggested if they are not supported.
 
 You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand. Once the status transitions from "creating" to "running" you may begin to use your instance.
 
@@ -50,12 +61,25 @@ This subcommand returns your instance ID, initial credentials, connection URL al
 
 You must also provide a --cloud-provider flag with the subcommand, which specifies which cloud provider the instances will be hosted in. The acceptable values for this field are gcp, aws, or azure.
 
-For Enterprise instances you can specify a --customer-managed-key-id flag to use a Customer Managed Key for encryption.`,
+For Enterprise instances you can specify a --customer-managed-key-id flag to use a Customer Managed Key for encryption.
+
+With --if-not-exists, an existing instance with the same name in the tenant is returned instead of creating a new one, which makes it safe to retry the command. If the create request gets no response, it is retried unless the instance is found to have been created. Initial credentials are only returned for a new instance.
+
+With --estimate, the hourly and monthly cost of the instance is printed instead of creating it, see the cost estimate subcommand for where prices are read from.
+
+When run in a terminal without the required flags, or with --interactive, the values that are not given as flags are asked for one at a time: the tenant, then the instance type, cloud provider, region and memory offered to that tenant, the name, and a ready customer managed key if there is one. A summary with the estimated cost and the equivalent command line is printed before asking for confirmation.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			wizard = interactive || output.IsTerminal(cmd.InOrStdin()) && (!cmd.Flags().Changed(typeFlag) || name == "" && !estimate ||
+				tenantId == "" && cfg.Aura.DefaultTenant() == "" ||
+				_type != "free-db" && (memory == "" || region == "" || cloudProvider == ""))
+
 			if _type != "free-db" {
-				cmd.MarkFlagRequired(memoryFlag)
-				cmd.MarkFlagRequired(regionFlag)
-				cmd.MarkFlagRequired(cloudProviderFlag)
+				// Otherwise the wizard asks for them
+				if !wizard {
+					cmd.MarkFlagRequired(memoryFlag)
+					cmd.MarkFlagRequired(regionFlag)
+					cmd.MarkFlagRequired(cloudProviderFlag)
+				}
 			} else {
 				if memory != "" {
 					return fmt.Errorf(`invalid argument "%s" for "--memory" flag: must not be set when "--type" flag is set to "free-db"`, memory)
@@ -72,14 +96,56 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 				return fmt.Errorf(`invalid argument "%s" for "--version" flag: must be one of "4" or "5"`, version)
 			}
 
-			if cfg.Aura.DefaultTenant() == "" {
-				cmd.MarkFlagRequired(tenantIdFlag)
+			if !wizard {
+				cmd.MarkFlagRequired(typeFlag)
+				if cfg.Aura.DefaultTenant() == "" {
+					cmd.MarkFlagRequired(tenantIdFlag)
+				}
+				if !estimate {
+					cmd.MarkFlagRequired(nameFlag)
+				}
 			}
 
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if tenantId == "" {
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+			var err error
+			tenantId, err = api.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
+			}
+
+			if wizard {
+				values := &createValues{
+					tenantId:             tenantId,
+					name:                 name,
+					version:              version,
+					instanceType:         _type.String(),
+					cloudProvider:        cloudProvider.String(),
+					region:               region,
+					memory:               memory.String(),
+					customerManagedKeyId: customerManagedKeyId,
+				}
+				create, err := runCreateWizard(cmd, cfg, values, estimate, priceFile)
+				if err != nil || !create {
+					return err
+				}
+				tenantId = values.tenantId
+				name = values.name
+				version = values.version
+				_type = flags.InstanceType(values.instanceType)
+				cloudProvider = flags.CloudProvider(values.cloudProvider)
+				region = values.region
+				memory = flags.Memory(values.memory)
+				customerManagedKeyId = values.customerManagedKeyId
+			}
+
 			body := map[string]any{
+				"tenant_id":      tenantId,
 				"version":        version,
 				"region":         region,
 				"name":           name,
@@ -87,12 +153,6 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 				"cloud_provider": cloudProvider,
 			}
 
-			if tenantId == "" {
-				body["tenant_id"] = cfg.Aura.DefaultTenant()
-			} else {
-				body["tenant_id"] = tenantId
-			}
-
 			if _type == "free-db" {
 				body["memory"] = "1GB"
 				body["region"] = "europe-west1"
@@ -101,24 +161,68 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 			} else {
 				body["memory"] = memory
 				body["region"] = region
+
+				if err := api.ValidateInstanceConfiguration(cfg, tenantId, api.InstanceConfiguration{
+					Type:          _type.String(),
+					CloudProvider: cloudProvider.String(),
+					Region:        region,
+					Memory:        memory.String(),
+				}); err != nil {
+					return err
+				}
+			}
+
+			if estimate {
+				pricing, err := cost.NewPricing(cfg, tenantId, priceFile)
+				if err != nil {
+					return err
+				}
+				instanceEstimate, err := pricing.EstimateOrError(api.InstanceConfiguration{
+					Type:          _type.String(),
+					CloudProvider: fmt.Sprint(body["cloud_provider"]),
+					Region:        fmt.Sprint(body["region"]),
+					Memory:        fmt.Sprint(body["memory"]),
+				})
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(instanceEstimate), cost.EstimateFields)
 			}
 
 			if customerManagedKeyId != "" {
-				body["customer_managed_key_id"] = customerManagedKeyId
+				body["customer_managed_key_id"], err = api.ResolveCMKId(cfg, customerManagedKeyId)
+				if err != nil {
+					return err
+				}
 			}
 
-			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
-				PostBody: body,
-				Method:   http.MethodPost,
-			})
+			var (
+				resBody    []byte
+				statusCode int
+				created    = true
+			)
+			if ifNotExists {
+				resBody, statusCode, created, err = api.CreateIfNotExists(cfg, "/instances", body, func() (map[string]any, error) {
+					return api.FindInstanceByName(cfg, tenantId, name)
+				})
+			} else {
+				resBody, statusCode, err = api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+					PostBody: body,
+					Method:   http.MethodPost,
+				})
+			}
 			if err != nil {
 				return err
 			}
+			if !created {
+				cmd.Printf("An instance named %s already exists, no new instance has been created\n", name)
+			}
 
 			// NOTE: Instance create should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for instance to be ready...")
@@ -146,18 +250,22 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 
 	cmd.Flags().Var(&memory, memoryFlag, "The size of the instance memory in GB.")
 
-	cmd.Flags().StringVar(&name, nameFlag, "", "(required) The name of the instance (any UTF-8 characters with no trailing or leading whitespace).")
-	cmd.MarkFlagRequired(nameFlag)
+	cmd.Flags().StringVar(&name, nameFlag, "", "The name of the instance (any UTF-8 characters with no trailing or leading whitespace). Required unless the wizard asks for it or --estimate is set.")
 
-	cmd.Flags().Var(&_type, typeFlag, "(required) The type of the instance.")
-	cmd.MarkFlagRequired(typeFlag)
+	cmd.Flags().Var(&_type, typeFlag, "The type of the instance. Required unless the wizard asks for it.")
 
 	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The Aura tenant/project ID")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
 
 	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "The cloud provider hosting the instance.")
 
 	cmd.Flags().StringVar(&customerManagedKeyId, customerManagedKeyIdFlag, "", "An optional customer managed key to be used for instance creation.")
+	completion.Flag(cmd, cfg, customerManagedKeyIdFlag, completion.CustomerManagedKey)
+	cmd.Flags().BoolVar(&ifNotExists, ifNotExistsFlag, false, "Returns the existing instance with the same name in the tenant instead of creating a new one")
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created instance is ready.")
+	cmd.Flags().BoolVar(&estimate, estimateFlag, false, "Prints the estimated cost of the instance instead of creating it")
+	cmd.Flags().StringVar(&priceFile, priceFileFlag, "", "A YAML or JSON file with prices, used with --estimate for instance configurations without a price")
+	cmd.Flags().BoolVarP(&interactive, interactiveFlag, "i", false, "Asks for the values of the instance that are not given as flags")
 
 	return cmd
 }
@@ -4,10 +4,29 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
+// A tenant offering professional and enterprise instances on GCP
+const tenantWithInstanceConfigurations = `{
+	"data": {
+		"id": "YOUR_TENANT_ID",
+		"name": "Production",
+		"instance_configurations": [
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "professional-db", "memory": "1GB", "storage": "2GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west2", "region_name": "London", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "us-central1", "region_name": "Iowa", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "enterprise-db", "memory": "8GB", "storage": "16GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "enterprise-db", "memory": "16GB", "storage": "32GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "enterprise-db", "memory": "64GB", "storage": "128GB", "version": "5"}
+		]
+	}
+}`
+
 func TestCreateFreeInstance(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -52,7 +71,8 @@ func TestCreateProfessionalInstance(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
 
-	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusAccepted, `{
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	mockHandler := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
 			"data": {
 				"id": "db1d1234",
 				"connection_url": "YOUR_CONNECTION_URL",
@@ -115,6 +135,20 @@ func TestCreateProfessionalInstanceNoTenant(t *testing.T) {
 `)
 }
 
+func TestCreateProfessionalInstanceNoName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, "")
+
+	helper.ExecuteCommand("instance create --region europe-west1 --type professional-db --memory 1GB --tenant-id YOUR_TENANT_ID --cloud-provider gcp")
+
+	mockHandler.AssertCalledTimes(0)
+
+	helper.AssertErr(`Error: required flag(s) "name" not set
+`)
+}
+
 func TestCreateProfessionalInstanceInvalidCloudProvider(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -129,6 +163,22 @@ func TestCreateProfessionalInstanceInvalidCloudProvider(t *testing.T) {
 `)
 }
 
+func TestCreateInstanceCompletesFlagValues(t *testing.T) {
+	for flag, expected := range map[string]string{
+		"cloud-provider": "aws\nazure\ngcp",
+		"type":           "free-db\nprofessional-db\nbusiness-critical\nenterprise-db\nprofessional-ds\nenterprise-ds",
+	} {
+		t.Run(flag, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.ExecuteCommand(fmt.Sprintf("__complete instance create --%s ''", flag))
+
+			helper.AssertOut(expected + "\n:4")
+		})
+	}
+}
+
 func TestCreateProfessionalInstanceInvalidMemory(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -237,7 +287,8 @@ func TestCreateInstanceError(t *testing.T) {
 			helper := testutils.NewAuraTestHelper(t)
 			defer helper.Close()
 
-			mockHandler := helper.NewRequestHandlerMock("/v1/instances", testCase.statusCode, testCase.returnBody)
+			helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+			mockHandler := helper.NewRequestHandlerMock("POST /v1/instances", testCase.statusCode, testCase.returnBody)
 
 			helper.ExecuteCommand("instance create --region europe-west1 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 4GB")
 
@@ -254,7 +305,8 @@ func TestInstanceWithCmkId(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
 
-	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusAccepted, `{
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	mockHandler := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
 			"data": {
 				"id": "db1d1234",
 				"connection_url": "YOUR_CONNECTION_URL",
@@ -387,3 +439,450 @@ Waiting for instance to be ready...
 Instance Status: ready
 	`)
 }
+
+func TestCreateInstanceIfNotExistsWithExistingInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "db1d1234",
+				"name": "Instance01",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			},
+			{
+				"id": "a1b2c3d4",
+				"name": "Instance02",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01",
+			"status": "running",
+			"tenant_id": "YOUR_TENANT_ID",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "professional-db",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance create --region europe-west1 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 4GB --if-not-exists --output table")
+
+	listMock.AssertCalledWithQueryParam("tenantId", "YOUR_TENANT_ID")
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertErr("")
+	helper.AssertOut(`An instance named Instance01 already exists, no new instance has been created
+┌──────────┬────────────┬────────────────┬─────────────────────┬──────────┬──────────┬────────────────┬──────────────┬─────────────────┐
+│ ID       │ NAME       │ TENANT_ID      │ CONNECTION_URL      │ USERNAME │ PASSWORD │ CLOUD_PROVIDER │ REGION       │ TYPE            │
+├──────────┼────────────┼────────────────┼─────────────────────┼──────────┼──────────┼────────────────┼──────────────┼─────────────────┤
+│ db1d1234 │ Instance01 │ YOUR_TENANT_ID │ YOUR_CONNECTION_URL │          │          │ gcp            │ europe-west1 │ professional-db │
+└──────────┴────────────┴────────────────┴─────────────────────┴──────────┴──────────┴────────────────┴──────────────┴─────────────────┘
+`)
+}
+
+func TestCreateInstanceIfNotExistsWithoutExistingInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"username": "neo4j",
+			"password": "letMeIn123!",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "free-db",
+			"name": "Instance01"
+		}
+	}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --if-not-exists")
+
+	listMock.AssertCalledTimes(1)
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{"cloud_provider":"gcp","memory":"1GB","name":"Instance01","region":"europe-west1","tenant_id":"YOUR_TENANT_ID","type":"free-db","version":"5"}`)
+
+	helper.AssertOutJson(`{
+	  "data": {
+		"cloud_provider": "gcp",
+		"connection_url": "YOUR_CONNECTION_URL",
+		"id": "db1d1234",
+		"name": "Instance01",
+		"password": "letMeIn123!",
+		"region": "europe-west1",
+		"tenant_id": "YOUR_TENANT_ID",
+		"type": "free-db",
+		"username": "neo4j"
+	  }
+	}`)
+}
+
+func TestCreateInstanceIfNotExistsRetriesWithoutResponse(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`).AddResponse(http.StatusOK, `{"data": []}`)
+	createMock := helper.NewNoResponseRequestHandlerMock("POST /v1/instances").AddResponse(http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"username": "neo4j",
+			"password": "letMeIn123!",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "free-db",
+			"name": "Instance01"
+		}
+	}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --if-not-exists")
+
+	listMock.AssertCalledTimes(2)
+	createMock.AssertCalledTimes(2)
+
+	helper.AssertOutJson(`{
+	  "data": {
+		"cloud_provider": "gcp",
+		"connection_url": "YOUR_CONNECTION_URL",
+		"id": "db1d1234",
+		"name": "Instance01",
+		"password": "letMeIn123!",
+		"region": "europe-west1",
+		"tenant_id": "YOUR_TENANT_ID",
+		"type": "free-db",
+		"username": "neo4j"
+	  }
+	}`)
+}
+
+func TestCreateInstanceIfNotExistsDoesNotRetryWhenCreatedWithoutResponse(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`).AddResponse(http.StatusOK, `{
+		"data": [
+			{
+				"id": "db1d1234",
+				"name": "Instance01",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01",
+			"status": "creating",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "free-db"
+		}
+	}`)
+	createMock := helper.NewNoResponseRequestHandlerMock("POST /v1/instances")
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --if-not-exists --output table")
+
+	createMock.AssertCalledTimes(1)
+
+	helper.AssertOut(`An instance named Instance01 already exists, no new instance has been created
+┌──────────┬────────────┬────────────────┬────────────────┬──────────┬──────────┬────────────────┬──────────────┬─────────┐
+│ ID       │ NAME       │ TENANT_ID      │ CONNECTION_URL │ USERNAME │ PASSWORD │ CLOUD_PROVIDER │ REGION       │ TYPE    │
+├──────────┼────────────┼────────────────┼────────────────┼──────────┼──────────┼────────────────┼──────────────┼─────────┤
+│ db1d1234 │ Instance01 │ YOUR_TENANT_ID │                │          │          │ gcp            │ europe-west1 │ free-db │
+└──────────┴────────────┴────────────────┴────────────────┴──────────┴──────────┴────────────────┴──────────────┴─────────┘
+`)
+}
+
+func TestCreateInstanceIfNotExistsWithDuplicateNames(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "db1d1234",
+				"name": "Instance01",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			},
+			{
+				"id": "a1b2c3d4",
+				"name": "Instance01",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --if-not-exists")
+
+	createMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: more than one instance is named Instance01, found db1d1234, a1b2c3d4")
+}
+
+func TestCreateInstanceEstimate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/6981ace7-efe8-4f5c-b7c5-267b5162ce91", http.StatusOK, `{
+		"data": {
+			"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"name": "Production",
+			"instance_configurations": [
+				{"cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "8GB", "price_per_hour": 0.8}
+			]
+		}
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance create --type enterprise-db --tenant-id 6981ace7-efe8-4f5c-b7c5-267b5162ce91 --cloud-provider gcp --region europe-west1 --memory 8GB --estimate")
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"cloud_provider": "gcp",
+			"currency": "USD",
+			"hourly_cost": 0.8,
+			"memory": "8GB",
+			"monthly_cost": 584,
+			"region": "europe-west1",
+			"type": "enterprise-db"
+		}
+	}`)
+}
+
+func TestCreateInstanceWithUnavailableRegion(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance create --region europe-west3 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 4GB")
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: region europe-west3 is not available for professional-db instances on gcp in tenant YOUR_TENANT_ID, the nearest regions are europe-west1, europe-west2, us-central1\n")
+}
+
+func TestCreateInstanceWithUnavailableMemory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance create --region europe-west1 --name Instance01 --type enterprise-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 32GB")
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: memory 32GB is not available for enterprise-db instances in europe-west1 in tenant YOUR_TENANT_ID, the nearest memory sizes are 8GB, 16GB, 64GB\n")
+}
+
+func TestCreateInstanceWithUnavailableCloudProvider(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance create --region eu-west-1 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider aws --memory 4GB")
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: cloud provider aws is not available for professional-db instances in tenant YOUR_TENANT_ID, available cloud providers are gcp\n")
+}
+
+func TestCreateInstanceCachesInstanceConfigurations(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+
+	helper.ExecuteCommand("instance create --region europe-west1 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 4GB")
+
+	helper.AssertErr("")
+	cache := helper.ReadFile(cachePath(helper, "instance-configurations-YOUR_TENANT_ID"))
+	assert.Contains(t, cache, `"value":[`)
+	assert.Contains(t, cache, `"region":"us-central1"`)
+}
+
+func TestCreateInstanceWithCachedInstanceConfigurations(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile(cachePath(helper, "instance-configurations-YOUR_TENANT_ID"), fmt.Sprintf(`{
+		"fetched_at": %d,
+		"value": [
+			{"cloud_provider": "gcp", "region": "europe-west1", "type": "professional-db", "memory": "4GB"}
+		]
+	}`, time.Now().UnixMilli()))
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+
+	helper.ExecuteCommand("instance create --region us-central1 --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --memory 4GB")
+
+	tenantMock.AssertCalledTimes(0)
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertErr("Error: region us-central1 is not available for professional-db instances on gcp in tenant YOUR_TENANT_ID, the nearest regions are europe-west1\n")
+}
+
+func TestCreateInstanceInteractively(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [
+			{"id": "a1b2c3d4-0000-0000-0000-000000000000", "name": "Staging"},
+			{"id": "YOUR_TENANT_ID", "name": "Production"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{"id": "f15cc45b-1c29-44e8-911f-3ba719f70ed7", "name": "Production Key", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "0d971cc4-f703-40fd-8c5c-f5ec134f6c84", "name": "Pending Key", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/f15cc45b-1c29-44e8-911f-3ba719f70ed7", http.StatusOK, `{
+		"data": {"id": "f15cc45b-1c29-44e8-911f-3ba719f70ed7", "name": "Production Key", "status": "ready", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/0d971cc4-f703-40fd-8c5c-f5ec134f6c84", http.StatusOK, `{
+		"data": {"id": "0d971cc4-f703-40fd-8c5c-f5ec134f6c84", "name": "Pending Key", "status": "pending", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db"}
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"username": "neo4j",
+			"password": "letMeIn123!",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"name": "My Instance"
+		}
+	}`)
+
+	helper.SetInput("2\n1\n7\n2\n\nMy Instance\n2\nyes\n")
+	helper.ExecuteCommand("instance create --interactive --output table")
+
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{"cloud_provider":"gcp","customer_managed_key_id":"f15cc45b-1c29-44e8-911f-3ba719f70ed7","memory":"16GB","name":"My Instance","region":"europe-west1","tenant_id":"YOUR_TENANT_ID","type":"enterprise-db","version":"5"}`)
+
+	helper.AssertErr("")
+	helper.AssertOut(`Choose the tenant:
+	1) Staging (a1b2c3d4-0000-0000-0000-000000000000)
+	2) Production (YOUR_TENANT_ID)
+Enter a number from 1 to 2: Choose the instance type:
+	1) enterprise-db
+	2) professional-db
+Enter a number from 1 to 2: Only one cloud provider is available: gcp
+Only one region is available: europe-west1 (Belgium)
+Choose the memory:
+	1) 8GB memory, 16GB storage
+	2) 16GB memory, 32GB storage
+	3) 64GB memory, 128GB storage
+Enter a number from 1 to 3: Enter a number from 1 to 3: Enter the name of the instance: Enter the name of the instance: Choose the customer managed key:
+	1) No customer managed key
+	2) Production Key (f15cc45b-1c29-44e8-911f-3ba719f70ed7)
+Enter a number from 1 to 2: Summary
+	Tenant:               YOUR_TENANT_ID
+	Name:                 My Instance
+	Type:                 enterprise-db
+	Version:              5
+	Cloud provider:       gcp
+	Region:               europe-west1
+	Memory:               16GB
+	Customer managed key: f15cc45b-1c29-44e8-911f-3ba719f70ed7
+	Estimated cost:       unknown, the instance configurations of tenant YOUR_TENANT_ID have no prices, use --price-file to provide a price file
+Equivalent command
+	aura instance create --tenant-id YOUR_TENANT_ID --name 'My Instance' --type enterprise-db --version 5 --cloud-provider gcp --region europe-west1 --memory 16GB --customer-managed-key-id f15cc45b-1c29-44e8-911f-3ba719f70ed7
+Do you want to create this instance? Only 'yes' will be accepted: ┌──────────┬─────────────┬────────────────┬─────────────────────┬──────────┬─────────────┬────────────────┬──────────────┬───────────────┐
+│ ID       │ NAME        │ TENANT_ID      │ CONNECTION_URL      │ USERNAME │ PASSWORD    │ CLOUD_PROVIDER │ REGION       │ TYPE          │
+├──────────┼─────────────┼────────────────┼─────────────────────┼──────────┼─────────────┼────────────────┼──────────────┼───────────────┤
+│ db1d1234 │ My Instance │ YOUR_TENANT_ID │ YOUR_CONNECTION_URL │ neo4j    │ letMeIn123! │ gcp            │ europe-west1 │ enterprise-db │
+└──────────┴─────────────┴────────────────┴─────────────────────┴──────────┴─────────────┴────────────────┴──────────────┴───────────────┘
+`)
+}
+
+func TestCreateInstanceInteractivelyWithFlags(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("prices.yaml", `
+currency: EUR
+prices:
+  - type: professional-db
+    memory: 4GB
+    price_per_hour: 0.25
+`)
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.SetInput("3\nno\n")
+	helper.ExecuteCommand("instance create -i --tenant-id YOUR_TENANT_ID --name Instance01 --type professional-db --memory 4GB --price-file prices.yaml")
+
+	tenantMock.AssertCalledTimes(1)
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertErr("")
+	helper.AssertOut(`Only one cloud provider is available: gcp
+Choose the region:
+	1) europe-west1 (Belgium)
+	2) europe-west2 (London)
+	3) us-central1 (Iowa)
+Enter a number from 1 to 3: Summary
+	Tenant:               YOUR_TENANT_ID
+	Name:                 Instance01
+	Type:                 professional-db
+	Version:              5
+	Cloud provider:       gcp
+	Region:               us-central1
+	Memory:               4GB
+	Estimated cost:       0.25 EUR per hour, 182.50 EUR per month
+Equivalent command
+	aura instance create --tenant-id YOUR_TENANT_ID --name Instance01 --type professional-db --version 5 --cloud-provider gcp --region us-central1 --memory 4GB
+Do you want to create this instance? Only 'yes' will be accepted: Create cancelled
+`)
+}
+
+func TestCreateInstanceInteractivelyWithoutAnswer(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{}`)
+
+	helper.SetInput("")
+	helper.ExecuteCommand("instance create --interactive --tenant-id YOUR_TENANT_ID")
+
+	createMock.AssertCalledTimes(0)
+
+	helper.AssertErr("Error: no instance type was given, the instance has not been created\n")
+}
@@ -6,23 +6,61 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
-		Use:   "delete <id>",
+	var (
+		autoApprove bool
+		bulk        bulkOptions
+	)
+
+	const (
+		autoApproveFlag = "auto-approve"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete [id]",
 		Short: "Deletes an instance",
 		Long: `Starts the deletion process of an Aura instance.
 
 Deleting an instance is an asynchronous operation. You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand.
 
-If another operation is being performed on the instance you are trying to delete, an error will be returned that indicates that deletion cannot be performed.`,
-		Args: cobra.ExactArgs(1),
+If another operation is being performed on the instance you are trying to delete, an error will be returned that indicates that deletion cannot be performed.
+
+` + bulkHelp + `
+
+Before deleting several instances, the selected instances are listed and you are asked to confirm, unless --auto-approve is set.`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return bulk.validate(args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s", args[0])
 			cmd.SilenceUsage = true
+			if bulk.isSet() {
+				instances, err := bulk.selectInstances(cfg)
+				if err != nil {
+					return err
+				}
+				if len(instances) > 0 && !autoApprove && !confirmBulk(cmd, instances, "deleted") {
+					cmd.Println("Delete cancelled")
+					return nil
+				}
+				return runBulk(cmd, cfg, &bulk, instances, func(instanceId string) ([]byte, error) {
+					resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+						Method: http.MethodDelete,
+					})
+					return resBody, err
+				}, false, "")
+			}
+
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s", instanceId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
@@ -32,10 +70,19 @@ If another operation is being performed on the instance you are trying to delete
 			}
 			// NOTE: Instance delete should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"}); err != nil {
+					return err
+				}
 			}
 
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&autoApprove, autoApproveFlag, false, "Deletes the selected instances without asking for confirmation")
+	addBulkFlags(cmd, cfg, &bulk)
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
+	return cmd
 }
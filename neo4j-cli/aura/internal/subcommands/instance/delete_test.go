@@ -99,3 +99,86 @@ func TestDeleteInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteInstancesByNameGlob(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "a1b2c3d4", "name": "production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	deleteMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "destroying"}
+	}`)
+
+	helper.SetInput("yes\n")
+	helper.ExecuteCommand("instance delete --name-glob dev-* --rate-limit 0 --output table")
+
+	deleteMock.AssertCalledTimes(1)
+	deleteMock.AssertCalledWithMethod(http.MethodDelete)
+
+	helper.AssertOut(`The following 1 instances will be deleted:
+	dev-one (2f49c2b3)
+Do you want to continue? Only 'yes' will be accepted: ┌──────────┬─────────┬────────────┬───────┐
+│ ID       │ NAME    │ STATUS     │ ERROR │
+├──────────┼─────────┼────────────┼───────┤
+│ 2f49c2b3 │ dev-one │ destroying │       │
+└──────────┴─────────┴────────────┴───────┘
+`)
+}
+
+func TestDeleteInstancesCancelled(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	deleteMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "destroying"}
+	}`)
+
+	helper.SetInput("no\n")
+	helper.ExecuteCommand("instance delete --all")
+
+	deleteMock.AssertCalledTimes(0)
+
+	helper.AssertOut(`The following 1 instances will be deleted:
+	dev-one (2f49c2b3)
+Do you want to continue? Only 'yes' will be accepted: Delete cancelled
+`)
+}
+
+func TestDeleteInstancesWithAutoApprove(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	deleteMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "destroying"}
+	}`)
+
+	helper.ExecuteCommand("instance delete --all --auto-approve")
+
+	deleteMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"error": "",
+				"id": "2f49c2b3",
+				"name": "dev-one",
+				"status": "destroying"
+			}
+		]
+	}`)
+}
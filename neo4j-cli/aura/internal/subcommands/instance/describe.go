@@ -0,0 +1,180 @@
+package instance
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewDescribeCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <id>",
+		Short: "Returns an instance with its related resources",
+		Long: `This subcommand returns everything related to an Aura instance at once, to help with troubleshooting:
+
+	the instance details
+	the snapshots of the current day, and the latest exportable one
+	the customer managed key the instance is encrypted with, and its status, or why it could not be fetched
+	the metrics integration URL
+	the GraphQL Data APIs of the instance with their authentication providers, when the beta is enabled
+
+With JSON output, everything is returned in a single document. With table output, a table is printed for every section. With csv and tsv output, which are parsed by other tools, only the instance is printed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			description, err := describeInstance(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case output.IsTable(cfg):
+				return printDescription(cmd, cfg, description)
+			case output.IsStructured(cfg):
+				return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(description), nil)
+			default:
+				// Other row based formats such as csv are parsed, so cannot be mixed with section titles or other tables
+				instance := description["instance"].(map[string]any)
+				return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(instance), instanceFields(instance))
+			}
+		},
+	}
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
+	return cmd
+}
+
+func describeInstance(cfg *clicfg.Config, instanceId string) (map[string]any, error) {
+	instance, err := api.GetInstance(cfg, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := api.ListSnapshots(cfg, instanceId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// A key that cannot be fetched, such as one that was deleted, is reported in its section rather than failing the description
+	var cmk map[string]any
+	cmkError := ""
+	if cmkId, ok := instance["customer_managed_key_id"].(string); ok && cmkId != "" {
+		if cmk, err = api.GetCMK(cfg, cmkId); err != nil {
+			cmkError = err.Error()
+		}
+	}
+
+	metricsIntegrationUrl := ""
+	if HasMetricsIntegrationEndpointUrl(instance) {
+		metricsIntegrationUrl = instance["metrics_integration_url"].(string)
+	}
+
+	description := map[string]any{
+		"instance":                   instance,
+		"snapshots":                  snapshots,
+		"latest_exportable_snapshot": latestExportableSnapshot(snapshots),
+		"customer_managed_key":       cmk,
+		"metrics_integration_url":    metricsIntegrationUrl,
+	}
+	if cmkError != "" {
+		description["customer_managed_key_error"] = cmkError
+	}
+
+	if !cfg.Aura.AuraBetaEnabled() {
+		return description, nil
+	}
+
+	graphQLDataApis, err := api.ListGraphQLDataApis(cfg, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, graphQLDataApi := range graphQLDataApis {
+		id, _ := graphQLDataApi["id"].(string)
+		authProviders, err := api.ListAuthProviders(cfg, instanceId, id)
+		if err != nil {
+			return nil, err
+		}
+		graphQLDataApi["auth_providers"] = authProviders
+	}
+	description["graphql_data_apis"] = graphQLDataApis
+
+	return description, nil
+}
+
+// The exportable snapshot with the latest timestamp, or nil if none is exportable
+func latestExportableSnapshot(snapshots []map[string]any) map[string]any {
+	var latest map[string]any
+	for _, snapshot := range snapshots {
+		if exportable, _ := snapshot["exportable"].(bool); !exportable {
+			continue
+		}
+		// Timestamps are in ISO 8601, so they sort as strings
+		timestamp, _ := snapshot["timestamp"].(string)
+		if latestTimestamp, _ := latest["timestamp"].(string); latest == nil || timestamp > latestTimestamp {
+			latest = snapshot
+		}
+	}
+	return latest
+}
+
+func printDescription(cmd *cobra.Command, cfg *clicfg.Config, description map[string]any) error {
+	instance := description["instance"].(map[string]any)
+
+	cmd.Println("Instance")
+	if err := output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(instance), instanceFields(instance)); err != nil {
+		return err
+	}
+
+	cmd.Println("Snapshots")
+	if err := output.PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(description["snapshots"].([]map[string]any)), []string{"snapshot_id", "profile", "status", "timestamp", "exportable"}); err != nil {
+		return err
+	}
+	if latest, _ := description["latest_exportable_snapshot"].(map[string]any); latest != nil {
+		cmd.Printf("Latest exportable snapshot: %s (%s)\n", latest["snapshot_id"], latest["timestamp"])
+	} else {
+		cmd.Println("No exportable snapshot")
+	}
+
+	if cmkError, _ := description["customer_managed_key_error"].(string); cmkError != "" {
+		cmd.Println("Customer managed key")
+		cmd.Printf("Customer managed key %s could not be fetched: %s\n", instance["customer_managed_key_id"], cmkError)
+	} else if cmk, _ := description["customer_managed_key"].(map[string]any); cmk != nil {
+		cmd.Println("Customer managed key")
+		if err := output.PrintSecondaryBodyMap(cmd, cfg, api.NewSingleValueResponseData(cmk), []string{"id", "name", "status", "type", "cloud_provider", "region"}); err != nil {
+			return err
+		}
+	}
+
+	graphQLDataApis, ok := description["graphql_data_apis"].([]map[string]any)
+	if !ok {
+		return nil
+	}
+	cmd.Println("GraphQL Data APIs")
+	if err := output.PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(graphQLDataApis), []string{"id", "name", "status", "url"}); err != nil {
+		return err
+	}
+	for _, graphQLDataApi := range graphQLDataApis {
+		cmd.Printf("Authentication providers of %s\n", graphQLDataApi["name"])
+		if err := output.PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(graphQLDataApi["auth_providers"].([]map[string]any)), []string{"id", "name", "type", "enabled", "url"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func instanceFields(instance map[string]any) []string {
+	fields := []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage"}
+	if HasMetricsIntegrationEndpointUrl(instance) {
+		fields = append(fields, "metrics_integration_url")
+	}
+	return fields
+}
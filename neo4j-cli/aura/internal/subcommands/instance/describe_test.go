@@ -0,0 +1,218 @@
+package instance_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const describedCMK = `{
+	"data": {
+		"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+		"name": "production-key",
+		"tenant_id": "YOUR_TENANT_ID",
+		"status": "ready",
+		"cloud_provider": "gcp",
+		"region": "europe-west1",
+		"type": "enterprise-db",
+		"key_id": "YOUR_KEY_ID"
+	}
+}`
+
+func mockDescribedInstance(helper *testutils.AuraTestHelper, cmkStatus int, cmkBody string) {
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "running",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"connection_url": "YOUR_CONNECTION_URL",
+			"metrics_integration_url": "YOUR_METRICS_INTEGRATION_ENDPOINT",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "8GB",
+			"storage": "16GB",
+			"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{
+		"data": [
+			{
+				"snapshot_id": "a1b2c3d4-0000-4000-8000-000000000001",
+				"instance_id": "2f49c2b3",
+				"profile": "AddHoc",
+				"status": "Completed",
+				"timestamp": "2026-10-19T08:00:00Z",
+				"exportable": true
+			},
+			{
+				"snapshot_id": "a1b2c3d4-0000-4000-8000-000000000002",
+				"instance_id": "2f49c2b3",
+				"profile": "Scheduled",
+				"status": "Completed",
+				"timestamp": "2026-10-19T10:00:00Z",
+				"exportable": true
+			},
+			{
+				"snapshot_id": "a1b2c3d4-0000-4000-8000-000000000003",
+				"instance_id": "2f49c2b3",
+				"profile": "Scheduled",
+				"status": "Pending",
+				"timestamp": "2026-10-19T12:00:00Z",
+				"exportable": false
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4", cmkStatus, cmkBody)
+}
+
+func TestDescribeInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockDescribedInstance(&helper, http.StatusOK, describedCMK)
+
+	helper.ExecuteCommand("instance describe 2f49c2b3 --output table")
+
+	helper.AssertOut(`Instance
+┌──────────┬────────────┬────────────────┬─────────┬─────────────────────┬────────────────┬──────────────┬───────────────┬────────┬─────────┬───────────────────────────────────┐
+│ ID       │ NAME       │ TENANT_ID      │ STATUS  │ CONNECTION_URL      │ CLOUD_PROVIDER │ REGION       │ TYPE          │ MEMORY │ STORAGE │ METRICS_INTEGRATION_URL           │
+├──────────┼────────────┼────────────────┼─────────┼─────────────────────┼────────────────┼──────────────┼───────────────┼────────┼─────────┼───────────────────────────────────┤
+│ 2f49c2b3 │ Production │ YOUR_TENANT_ID │ running │ YOUR_CONNECTION_URL │ gcp            │ europe-west1 │ enterprise-db │ 8GB    │ 16GB    │ YOUR_METRICS_INTEGRATION_ENDPOINT │
+└──────────┴────────────┴────────────────┴─────────┴─────────────────────┴────────────────┴──────────────┴───────────────┴────────┴─────────┴───────────────────────────────────┘
+Snapshots
+┌──────────────────────────────────────┬───────────┬───────────┬──────────────────────┬────────────┐
+│ SNAPSHOT_ID                          │ PROFILE   │ STATUS    │ TIMESTAMP            │ EXPORTABLE │
+├──────────────────────────────────────┼───────────┼───────────┼──────────────────────┼────────────┤
+│ a1b2c3d4-0000-4000-8000-000000000001 │ AddHoc    │ Completed │ 2026-10-19T08:00:00Z │ true       │
+│ a1b2c3d4-0000-4000-8000-000000000002 │ Scheduled │ Completed │ 2026-10-19T10:00:00Z │ true       │
+│ a1b2c3d4-0000-4000-8000-000000000003 │ Scheduled │ Pending   │ 2026-10-19T12:00:00Z │ false      │
+└──────────────────────────────────────┴───────────┴───────────┴──────────────────────┴────────────┘
+Latest exportable snapshot: a1b2c3d4-0000-4000-8000-000000000002 (2026-10-19T10:00:00Z)
+Customer managed key
+┌──────────────────────────────────────┬────────────────┬────────┬───────────────┬────────────────┬──────────────┐
+│ ID                                   │ NAME           │ STATUS │ TYPE          │ CLOUD_PROVIDER │ REGION       │
+├──────────────────────────────────────┼────────────────┼────────┼───────────────┼────────────────┼──────────────┤
+│ f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4 │ production-key │ ready  │ enterprise-db │ gcp            │ europe-west1 │
+└──────────────────────────────────────┴────────────────┴────────┴───────────────┴────────────────┴──────────────┘
+`)
+}
+
+func TestDescribeInstanceWithMissingCMK(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockDescribedInstance(&helper, http.StatusNotFound, `{
+		"errors": [
+			{
+				"message": "Customer managed key not found",
+				"reason": "not-found"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance describe 2f49c2b3 --output table")
+
+	helper.AssertErr("")
+	helper.AssertOut(`Instance
+┌──────────┬────────────┬────────────────┬─────────┬─────────────────────┬────────────────┬──────────────┬───────────────┬────────┬─────────┬───────────────────────────────────┐
+│ ID       │ NAME       │ TENANT_ID      │ STATUS  │ CONNECTION_URL      │ CLOUD_PROVIDER │ REGION       │ TYPE          │ MEMORY │ STORAGE │ METRICS_INTEGRATION_URL           │
+├──────────┼────────────┼────────────────┼─────────┼─────────────────────┼────────────────┼──────────────┼───────────────┼────────┼─────────┼───────────────────────────────────┤
+│ 2f49c2b3 │ Production │ YOUR_TENANT_ID │ running │ YOUR_CONNECTION_URL │ gcp            │ europe-west1 │ enterprise-db │ 8GB    │ 16GB    │ YOUR_METRICS_INTEGRATION_ENDPOINT │
+└──────────┴────────────┴────────────────┴─────────┴─────────────────────┴────────────────┴──────────────┴───────────────┴────────┴─────────┴───────────────────────────────────┘
+Snapshots
+┌──────────────────────────────────────┬───────────┬───────────┬──────────────────────┬────────────┐
+│ SNAPSHOT_ID                          │ PROFILE   │ STATUS    │ TIMESTAMP            │ EXPORTABLE │
+├──────────────────────────────────────┼───────────┼───────────┼──────────────────────┼────────────┤
+│ a1b2c3d4-0000-4000-8000-000000000001 │ AddHoc    │ Completed │ 2026-10-19T08:00:00Z │ true       │
+│ a1b2c3d4-0000-4000-8000-000000000002 │ Scheduled │ Completed │ 2026-10-19T10:00:00Z │ true       │
+│ a1b2c3d4-0000-4000-8000-000000000003 │ Scheduled │ Pending   │ 2026-10-19T12:00:00Z │ false      │
+└──────────────────────────────────────┴───────────┴───────────┴──────────────────────┴────────────┘
+Latest exportable snapshot: a1b2c3d4-0000-4000-8000-000000000002 (2026-10-19T10:00:00Z)
+Customer managed key
+Customer managed key f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4 could not be fetched: [Customer managed key not found]`)
+}
+
+func TestDescribeInstanceWithCsvOutputPrintsOnlyInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockDescribedInstance(&helper, http.StatusOK, describedCMK)
+
+	helper.ExecuteCommand("instance describe 2f49c2b3 --output csv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`id,name,tenant_id,status,connection_url,cloud_provider,region,type,memory,storage,metrics_integration_url
+2f49c2b3,Production,YOUR_TENANT_ID,running,YOUR_CONNECTION_URL,gcp,europe-west1,enterprise-db,8GB,16GB,YOUR_METRICS_INTEGRATION_ENDPOINT`)
+}
+
+func TestDescribeInstanceJsonWithGraphQLDataApis(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "running"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{
+		"data": []
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "7bc2a5d1",
+				"name": "orders-api",
+				"status": "ready",
+				"url": "https://7bc2a5d1.graphql.neo4j.io/graphql"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/7bc2a5d1/auth-providers", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794-e40e-41f7-8e8c-1e5d0c1b3b2a",
+				"name": "default",
+				"type": "api-key",
+				"enabled": true
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance describe 2f49c2b3")
+
+	helper.AssertOutJson(`{
+		"data": {
+			"customer_managed_key": null,
+			"graphql_data_apis": [
+				{
+					"auth_providers": [
+						{
+							"enabled": true,
+							"id": "1ad1b794-e40e-41f7-8e8c-1e5d0c1b3b2a",
+							"name": "default",
+							"type": "api-key"
+						}
+					],
+					"id": "7bc2a5d1",
+					"name": "orders-api",
+					"status": "ready",
+					"url": "https://7bc2a5d1.graphql.neo4j.io/graphql"
+				}
+			],
+			"instance": {
+				"id": "2f49c2b3",
+				"name": "Production",
+				"status": "running"
+			},
+			"latest_exportable_snapshot": null,
+			"metrics_integration_url": "",
+			"snapshots": []
+		}
+	}`)
+}
@@ -8,20 +8,24 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get <id>",
 		Short: "Returns instance details",
 		Long:  "This endpoint returns details about a specific Aura Instance.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
+			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s", instanceId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -34,12 +38,20 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				output.PrintBody(cmd, cfg, resBody, fields)
+				if err := output.PrintBody(cmd, cfg, resBody, fields); err != nil {
+					return err
+				}
 			}
 
 			return nil
 		},
 	}
+
+	output.AddWatchFlags(cmd)
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
+	return cmd
 }
 
 func getFields(resBody []byte) ([]string, error) {
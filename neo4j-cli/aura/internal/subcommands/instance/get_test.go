@@ -2,11 +2,16 @@ package instance_test
 
 import (
 	"fmt"
+	"hash/fnv"
 	"net/http"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
@@ -134,6 +139,56 @@ func TestGetProfessionalInstanceWithTableOutput(t *testing.T) {
 
 }
 
+func TestGetInstanceWatchUntilRunning(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {"id": "2f49c2b3", "name": "Production", "status": "resuming"}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {"id": "2f49c2b3", "name": "Production", "status": "running"}
+		}`)
+
+	helper.ExecuteCommand("instance get 2f49c2b3 --watch=1ms --until status=running --output ndjson")
+
+	mockHandler.AssertCalledTimes(2)
+	helper.AssertErr("")
+	helper.AssertOut(`Every 1ms: aura instance get 2f49c2b3
+{"id":"2f49c2b3","name":"Production","status":"resuming"}
+
+Every 1ms: aura instance get 2f49c2b3
+{"id":"2f49c2b3","name":"Production","status":"running"}`)
+}
+
+func TestGetInstanceWatchMarksChangedStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {"id": "2f49c2b3", "name": "Production", "status": "resuming"}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {"id": "2f49c2b3", "name": "Production", "status": "running"}
+		}`)
+
+	helper.ExecuteCommand("instance get 2f49c2b3 --watch=1ms --until status=running --output table --columns id,status")
+
+	mockHandler.AssertCalledTimes(2)
+	helper.AssertErr("")
+	helper.AssertOut(`Every 1ms: aura instance get 2f49c2b3
+┌──────────┬──────────┐
+│ ID       │ STATUS   │
+├──────────┼──────────┤
+│ 2f49c2b3 │ resuming │
+└──────────┴──────────┘
+
+Every 1ms: aura instance get 2f49c2b3
+┌──────────┬───────────┐
+│ ID       │ STATUS    │
+├──────────┼───────────┤
+│ 2f49c2b3 │ running * │
+└──────────┴───────────┘`)
+}
+
 func TestGetInstanceNotFoundError(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -215,3 +270,156 @@ func TestUnauthorizedAccessTokenRefresh(t *testing.T) {
 		})
 	}
 }
+
+func mockInstanceList(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			},
+			{
+				"id": "2f4a9d11",
+				"name": "Staging",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+}
+
+func TestGetInstanceByIdPrefixOrName(t *testing.T) {
+	for _, value := range []string{"2f49", "name:Production"} {
+		helper := testutils.NewAuraTestHelper(t)
+		defer helper.Close()
+
+		mockInstanceList(&helper)
+		mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"name": "Production",
+				"status": "running",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp",
+				"connection_url": "YOUR_CONNECTION_URL",
+				"region": "europe-west1",
+				"type": "enterprise-db",
+				"memory": "8GB",
+				"storage": "16GB"
+			}
+		}`)
+
+		helper.ExecuteCommand(fmt.Sprintf("instance get %s", value))
+
+		mockHandler.AssertCalledTimes(1)
+		helper.AssertOutJson(`{
+			"data": {
+				"cloud_provider": "gcp",
+				"connection_url": "YOUR_CONNECTION_URL",
+				"id": "2f49c2b3",
+				"memory": "8GB",
+				"name": "Production",
+				"region": "europe-west1",
+				"status": "running",
+				"storage": "16GB",
+				"tenant_id": "YOUR_TENANT_ID",
+				"type": "enterprise-db"
+			}
+		}`)
+	}
+}
+
+func TestGetInstanceWithAmbiguousIdPrefix(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstanceList(&helper)
+
+	helper.ExecuteCommand("instance get 2f4")
+
+	helper.AssertErr(`Error: 2f4 matches more than one instance, use the full ID:
+	2f49c2b3 (Production)
+	2f4a9d11 (Staging)`)
+}
+
+func TestGetInstanceWithUnknownIdPrefixOrName(t *testing.T) {
+	tests := map[string]string{
+		"abc":          "Error: no instance ID starts with abc",
+		"name:Testing": "Error: no instance is named Testing",
+	}
+
+	for value, expectedError := range tests {
+		helper := testutils.NewAuraTestHelper(t)
+		defer helper.Close()
+
+		mockInstanceList(&helper)
+
+		helper.ExecuteCommand(fmt.Sprintf("instance get %s", value))
+
+		helper.AssertErr(expectedError)
+	}
+}
+
+func TestGetInstanceCompletesIds(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "Northwind", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"},
+			{"id": "2a1b3c4d", "name": "Staging", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "azure"}
+		]
+	}`)
+
+	helper.ExecuteCommand("__complete instance get 2")
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertOut(`2f49c2b3	Recommendations
+2a1b3c4d	Staging
+:4`)
+	assert.Contains(t, helper.ReadFile(cachePath(helper, "completions-instances")), `"value":["2f49c2b3\tRecommendations","b51dc964\tNorthwind","2a1b3c4d\tStaging"]`)
+}
+
+func TestGetInstanceCompletesIdsFromCache(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		fetchedAt time.Time
+		calls     int
+		expected  string
+	}{
+		"fresh cache": {
+			fetchedAt: time.Now(),
+			calls:     0,
+			expected:  "c3d4e5f6\tCached",
+		},
+		"expired cache": {
+			fetchedAt: time.Now().Add(-2 * time.Minute),
+			calls:     1,
+			expected:  "b51dc964\tNorthwind",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [{"id": "b51dc964", "name": "Northwind", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}]
+			}`)
+			helper.SetFile(cachePath(helper, "completions-instances"), fmt.Sprintf(`{"fetched_at": %d, "value": ["c3d4e5f6\tCached"]}`, testCase.fetchedAt.UnixMilli()))
+
+			helper.ExecuteCommand("__complete instance get ''")
+
+			mockHandler.AssertCalledTimes(testCase.calls)
+			helper.AssertOut(strings.ReplaceAll(testCase.expected, "\\t", "\t") + "\n:4")
+		})
+	}
+}
+
+// The cache file of a value, which depends on the base URL and the credential of the test helper
+func cachePath(helper testutils.AuraTestHelper, name string) string {
+	hash := fnv.New64a()
+	hash.Write([]byte(helper.Server.URL + "/v1\ntest-cred"))
+	return filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "cache", fmt.Sprintf("%s-%x.json", name, hash.Sum64()))
+}
@@ -5,28 +5,56 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
-	var tenantId string
+	var (
+		tenantId       string
+		allCredentials bool
+		listOptions    output.ListOptions
+	)
+
+	const (
+		tenantIdFlag       = "tenant-id"
+		allCredentialsFlag = "all-credentials"
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of instances",
 		Long: `This subcommand returns a list containing a summary of each of your Aura instances. To find out more about a specific instance, retrieve the details using the get subcommand.
 
-You can filter instances in a particular tenant using --tenant-id. If the tenant flag is not specified, this subcommand lists all instances a user has access to across all tenants.`,
+You can filter instances in a particular tenant using --tenant-id. If the tenant flag is not specified, this subcommand lists all instances a user has access to across all tenants.
+
+With --all-credentials, the instances are listed with every stored credential rather than only the default one, and a credential column shows the credential each instance was listed with.
+
+The listed instances can be filtered with --filter, such as --filter 'cloud_provider=gcp,name~prod*', and sorted with --sort, such as --sort name.
+
+With --watch, the details of every instance are fetched at each refresh, so that the instances are listed with their status and can be watched with --until, such as --until status=running.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := "/instances"
 
+			cmd.SilenceUsage = true
+			if allCredentials {
+				instances, err := api.ListWithAllCredentials(cfg, path, nil)
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.NewResponseData(instances)), []string{api.CredentialField, "id", "name", "tenant_id", "cloud_provider"})
+			}
+
 			queryParams := make(map[string]string)
 			if tenantId != "" {
-				queryParams["tenantId"] = tenantId
+				resolvedTenantId, err := api.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+				queryParams["tenantId"] = resolvedTenantId
 			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
@@ -36,13 +64,34 @@ You can filter instances in a particular tenant using --tenant-id. If the tenant
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "cloud_provider"})
+				values := api.ParseBody(resBody)
+				fields := []string{"id", "name", "tenant_id", "cloud_provider"}
+				// The list has no status, which is what instances are watched for
+				if output.IsWatching(cmd) {
+					details, err := api.GetInstances(cfg, values.AsArray())
+					if err != nil {
+						return err
+					}
+					values = api.NewResponseData(details)
+					fields = append(fields, "status")
+				}
+				if err := output.PrintBodyMap(cmd, cfg, listOptions.Apply(values), fields); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "An optional Tenant ID to filter instances in a tenant")
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "An optional Tenant ID to filter instances in a tenant")
+	completion.Flag(cmd, cfg, tenantIdFlag, completion.Tenant)
+	cmd.Flags().BoolVar(&allCredentials, allCredentialsFlag, false, "Lists the instances of every stored credential")
+	cmd.MarkFlagsMutuallyExclusive(tenantIdFlag, allCredentialsFlag)
+
+	output.AddListFlags(cmd, &listOptions)
+
+	output.AddWatchFlags(cmd)
+	cmd.MarkFlagsMutuallyExclusive(allCredentialsFlag, "watch")
 
 	return cmd
 }
@@ -152,3 +152,461 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
+
+func TestListInstancesWithAllCredentials(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]any{
+		{"name": "analytics", "access-token": "dsa", "token-expiry": 123},
+		{"name": "retail", "access-token": "dsa", "token-expiry": 123},
+	})
+	helper.SetCredentialsValue("aura.default-credential", "analytics")
+
+	body := `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, body).AddResponse(http.StatusOK, body)
+
+	helper.ExecuteCommand("instance list --all-credentials --output table")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut(`┌────────────┬──────────┬────────────┬────────────────┬────────────────┐
+│ CREDENTIAL │ ID       │ NAME       │ TENANT_ID      │ CLOUD_PROVIDER │
+├────────────┼──────────┼────────────┼────────────────┼────────────────┤
+│ analytics  │ 2f49c2b3 │ Production │ YOUR_TENANT_ID │ gcp            │
+│ retail     │ 2f49c2b3 │ Production │ YOUR_TENANT_ID │ gcp            │
+└────────────┴──────────┴────────────┴────────────────┴────────────────┘
+`)
+}
+
+func TestListInstancesWithAllCredentialsAndTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance list --all-credentials --tenant-id my-tenant-id")
+
+	helper.AssertErr("Error: if any flags in the group [tenant-id all-credentials] are set none of the others can be; [all-credentials tenant-id] were all set\n")
+}
+
+func TestListInstancesWithOutputFormats(t *testing.T) {
+	testCases := map[string]string{
+		"yaml": `data:
+  - cloud_provider: gcp
+    id: 2f49c2b3
+    name: Production
+    tenant_id: YOUR_TENANT_ID
+  - cloud_provider: aws
+    id: b51dc964
+    name: Instance01, the first one
+    tenant_id: YOUR_TENANT_ID
+`,
+		"csv": `id,name,tenant_id,cloud_provider
+2f49c2b3,Production,YOUR_TENANT_ID,gcp
+b51dc964,"Instance01, the first one",YOUR_TENANT_ID,aws
+`,
+		"tsv": "id\tname\ttenant_id\tcloud_provider\n2f49c2b3\tProduction\tYOUR_TENANT_ID\tgcp\nb51dc964\tInstance01, the first one\tYOUR_TENANT_ID\taws\n",
+		"ndjson": `{"cloud_provider":"gcp","id":"2f49c2b3","name":"Production","tenant_id":"YOUR_TENANT_ID"}
+{"cloud_provider":"aws","id":"b51dc964","name":"Instance01, the first one","tenant_id":"YOUR_TENANT_ID"}
+`,
+	}
+
+	for format, expected := range testCases {
+		t.Run(format, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp"
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01, the first one",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand("instance list --output " + format)
+
+			helper.AssertErr("")
+			helper.AssertOut(expected)
+		})
+	}
+}
+
+func TestListInstancesWithTemplateAndJsonPathOutput(t *testing.T) {
+	testCases := map[string]string{
+		`--output 'template={{.id}} {{upper .cloud_provider}} {{date "2006-01-02" .created}} {{default "none" .customer_managed_key_id}}'`: `2f49c2b3 GCP 2024-06-01 none
+b51dc964 AWS 2024-07-15 f15cc45b`,
+		`--output 'template={{join .tags ","}}'`:                            "production,graph\n",
+		`--output 'jsonpath={.data[*].id}'`:                                 "2f49c2b3 b51dc964",
+		`--output 'jsonpath={.data[-1].name}'`:                              "Instance01",
+		`--output 'jsonpath={.data[?(@.cloud_provider=="aws")].id}'`:        "b51dc964",
+		`--output 'jsonpath={range .data[*]}{.id}{"\t"}{.name}{"\n"}{end}'`: "2f49c2b3\tProduction\nb51dc964\tInstance01",
+		`--output 'jsonpath={..tags[0]}'`:                                   "production",
+	}
+
+	for flags, expected := range testCases {
+		t.Run(flags, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"cloud_provider": "gcp",
+						"created": "2024-06-01T10:00:00Z",
+						"tags": ["production", "graph"]
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"cloud_provider": "aws",
+						"created": "2024-07-15T08:30:00Z",
+						"customer_managed_key_id": "f15cc45b"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand("instance list " + flags)
+
+			helper.AssertErr("")
+			helper.AssertOut(expected)
+		})
+	}
+}
+
+func TestListInstancesWithInvalidOutputTemplate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance list --output 'template={{.id'")
+
+	helper.AssertErr("Error: invalid output template: template: output:1: unclosed action\n")
+}
+
+func TestListInstancesWithOutputTemplateThatFails(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tags": ["production", "graph"]
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance list --output 'template={{index .tags 2}}'")
+
+	helper.AssertErr("Error: cannot execute output template: template: output:1:2: executing \"output\" at <index .tags 2>: error calling index: reflect: slice index out of range\n")
+}
+
+func TestListInstancesWithInvalidOutputJsonPath(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance list --output 'jsonpath={range .data[*]}{.id}'")
+
+	helper.AssertErr("Error: invalid output JSONPath {range .data[*]}{.id}: {range} without {end}\n")
+}
+
+func TestListInstancesWithFilterAndSort(t *testing.T) {
+	testCases := map[string]string{
+		`--filter status=running`:                          "2f49c2b3 432392ae",
+		`--filter 'status=RUNNING,type~enterprise*'`:       "432392ae",
+		`--filter 'type!~enterprise*'`:                     "2f49c2b3 b51dc964",
+		`--filter 'status!=running'`:                       "b51dc964",
+		`--filter 'memory>=8GB'`:                           "b51dc964 432392ae",
+		`--filter 'name~?nstance*'`:                        "b51dc964",
+		`--filter 'metrics.integration=enabled'`:           "432392ae",
+		`--filter 'created<2024-07-01'`:                    "2f49c2b3",
+		`--sort name`:                                      "b51dc964 2f49c2b3 432392ae",
+		`--sort -memory`:                                   "432392ae b51dc964 2f49c2b3",
+		`--sort status,-created`:                           "b51dc964 432392ae 2f49c2b3",
+		`--sort metrics.integration`:                       "432392ae 2f49c2b3 b51dc964",
+		`--filter 'status=running' --sort -name`:           "432392ae 2f49c2b3",
+		`--filter 'status=deleting'`:                       "",
+		`--filter 'cloud_provider=gcp' --sort -created,id`: "432392ae 2f49c2b3",
+	}
+
+	for flags, expected := range testCases {
+		t.Run(flags, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"cloud_provider": "gcp",
+						"status": "running",
+						"type": "professional-db",
+						"memory": "4GB",
+						"created": "2024-06-01T10:00:00Z"
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"cloud_provider": "aws",
+						"status": "paused",
+						"type": "professional-db",
+						"memory": "16GB",
+						"created": "2024-07-15T08:30:00Z"
+					},
+					{
+						"id": "432392ae",
+						"name": "Recommendations",
+						"cloud_provider": "gcp",
+						"status": "running",
+						"type": "enterprise-db",
+						"memory": "64GB",
+						"created": "2024-08-20T12:00:00Z",
+						"metrics": {"integration": "enabled"}
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand("instance list --output 'jsonpath={.data[*].id}' " + flags)
+
+			helper.AssertErr("")
+			helper.AssertOut(expected)
+		})
+	}
+}
+
+func TestListInstancesWithInvalidFilterAndSort(t *testing.T) {
+	testCases := map[string]string{
+		`--filter status`:     `Error: invalid argument "status" for "--filter" flag: condition "status" must be a field, one of the operators != !~ >= <= = ~ > <, and a value`,
+		`--filter '=running'`: `Error: invalid argument "=running" for "--filter" flag: condition "=running" must be a field, one of the operators != !~ >= <= = ~ > <, and a value`,
+		`--sort 'name,,id'`:   `Error: invalid argument "name,,id" for "--sort" flag: must be a comma separated list of fields, such as "name,-created"`,
+	}
+
+	for flags, expected := range testCases {
+		t.Run(flags, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.ExecuteCommand("instance list " + flags)
+
+			helper.AssertErr(expected + "\n")
+		})
+	}
+}
+
+func TestListInstancesWithColumns(t *testing.T) {
+	testCases := map[string]struct {
+		flags   string
+		columns string
+		out     string
+	}{
+		"columns": {
+			flags: "--output csv --columns id,status,memory,metrics.integration",
+			out: `id,status,memory,metrics.integration
+2f49c2b3,running,4GB,
+432392ae,paused,64GB,enabled`,
+		},
+		"no headers": {
+			flags: "--output tsv --no-headers",
+			out:   "2f49c2b3\tProduction\tYOUR_TENANT_ID\tgcp\n432392ae\tRecommendations\tYOUR_TENANT_ID\tgcp",
+		},
+		"table without headers": {
+			flags: "--output table --columns id,name --no-headers",
+			out: `┌──────────┬─────────────────┐
+│ 2f49c2b3 │ Production      │
+│ 432392ae │ Recommendations │
+└──────────┴─────────────────┘`,
+		},
+		"default columns in config": {
+			flags:   "--output csv",
+			columns: "id, memory",
+			out: `id,memory
+2f49c2b3,4GB
+432392ae,64GB`,
+		},
+		"columns over default columns in config": {
+			flags:   "--output csv --columns name",
+			columns: "id,memory",
+			out: `name
+Production
+Recommendations`,
+		},
+		"default columns in config with json": {
+			flags:   "--output ndjson",
+			columns: "id",
+			out: `{"cloud_provider":"gcp","id":"2f49c2b3","memory":"4GB","name":"Production","status":"running","tenant_id":"YOUR_TENANT_ID"}
+{"cloud_provider":"gcp","id":"432392ae","memory":"64GB","metrics":{"integration":"enabled"},"name":"Recommendations","status":"paused","tenant_id":"YOUR_TENANT_ID"}`,
+		},
+		"quiet": {
+			flags: "--output table -q",
+			out:   "2f49c2b3\n432392ae",
+		},
+		"quiet with all credentials": {
+			flags: "--all-credentials --quiet --filter status=paused",
+			out:   "432392ae",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			if testCase.columns != "" {
+				helper.SetConfigValue("aura.columns.instance-list", testCase.columns)
+			}
+			helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp",
+						"status": "running",
+						"memory": "4GB"
+					},
+					{
+						"id": "432392ae",
+						"name": "Recommendations",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp",
+						"status": "paused",
+						"memory": "64GB",
+						"metrics": {"integration": "enabled"}
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand("instance list " + testCase.flags)
+
+			helper.AssertErr("")
+			helper.AssertOut(testCase.out)
+		})
+	}
+}
+
+func TestListInstancesNotFittedToWidthWhenOutputIsNotTerminal(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("COLUMNS", "60")
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3-0a4b-4d1c-9a8e-8f3f2a1b6c7d",
+				"name": "Production graph for the recommendations service",
+				"status": "running",
+				"tags": ["production", "recommendations"]
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance list --output table --columns id,name,status,tags")
+
+	helper.AssertErr("")
+	helper.AssertOut(`┌──────────────────────────────────────┬──────────────────────────────────────────────────┬─────────┬─────────────────────────────┐
+│ ID                                   │ NAME                                             │ STATUS  │ TAGS                        │
+├──────────────────────────────────────┼──────────────────────────────────────────────────┼─────────┼─────────────────────────────┤
+│ 2f49c2b3-0a4b-4d1c-9a8e-8f3f2a1b6c7d │ Production graph for the recommendations service │ running │ production, recommendations │
+└──────────────────────────────────────┴──────────────────────────────────────────────────┴─────────┴─────────────────────────────┘`)
+}
+
+func TestListInstancesWatchUntilRunning(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	list := `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51dc964", "name": "Instance01", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}
+		]
+	}`
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, list).AddResponse(http.StatusOK, list).AddResponse(http.StatusOK, list)
+	productionMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "creating", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	}`).AddResponse(http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "creating", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	}`).AddResponse(http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "running", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	}`)
+	instance01 := `{
+		"data": {"id": "b51dc964", "name": "Instance01", "status": "running", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}
+	}`
+	instance01Mock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, instance01).AddResponse(http.StatusOK, instance01).AddResponse(http.StatusOK, instance01)
+
+	helper.ExecuteCommand("instance list --watch=1ms --until status=running --output csv --columns id,status")
+
+	listMock.AssertCalledTimes(3)
+	productionMock.AssertCalledTimes(3)
+	instance01Mock.AssertCalledTimes(3)
+	helper.AssertErr("")
+	helper.AssertOut(`Every 1ms: aura instance list
+id,status
+2f49c2b3,creating
+b51dc964,running
+
+Every 1ms: aura instance list
+id,status
+2f49c2b3,creating
+b51dc964,running
+
+Every 1ms: aura instance list
+id,status
+2f49c2b3,running
+b51dc964,running`)
+}
+
+func TestListInstancesWatchUntilRunningWaitsForInstances(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`).AddResponse(http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "running", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	}`)
+
+	helper.ExecuteCommand("instance list --watch=1ms --until status=running --output csv")
+
+	listMock.AssertCalledTimes(2)
+	getMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Every 1ms: aura instance list
+id,name,tenant_id,cloud_provider,status
+
+Every 1ms: aura instance list
+id,name,tenant_id,cloud_provider,status
+2f49c2b3,Production,YOUR_TENANT_ID,gcp,running`)
+}
+
+func TestListInstancesUntilWithoutWatch(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance list --until status=running")
+
+	helper.AssertErr("Error: --until can only be used with --watch")
+}
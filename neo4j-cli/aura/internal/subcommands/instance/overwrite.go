@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +16,13 @@ func NewOverwriteCmd(cfg *clicfg.Config) *cobra.Command {
 		sourceInstanceId string
 		sourceSnapshotId string
 		await            bool
+		dryRun           bool
 	)
 
 	const (
 		sourceInstanceIdFlag = "source-instance-id"
 		sourceSnapshotIdFlag = "source-snapshot-id"
+		dryRunFlag           = "dry-run"
 	)
 
 	cmd := &cobra.Command{
@@ -30,24 +33,41 @@ func NewOverwriteCmd(cfg *clicfg.Config) *cobra.Command {
 The overwrite process mimics the 'Clone to existing' functionality of the Aura Console.
 
 If only --source-instance-id is provided, a new snapshot of that instance is created and used for overwriting. Alternatively, you can specify an additional --source-snapshot-id to use a specific snapshot for overwriting, from --source-instance-id provided, otherwise as a snapshot of the instance being overwritten. The snapshot specified must be exportable.
+
+Use --dry-run to print the request that would be sent without overwriting the instance.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
-			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
-
 			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
 
 			postBody := make(map[string]any)
 			if sourceInstanceId == "" {
 				sourceInstanceId = instanceId
+			} else {
+				sourceInstanceId, err = api.ResolveInstanceId(cfg, sourceInstanceId)
+				if err != nil {
+					return err
+				}
 			}
 			postBody["source_instance_id"] = sourceInstanceId
 
 			if sourceSnapshotId != "" {
+				sourceSnapshotId, err = api.ResolveSnapshotId(cfg, sourceInstanceId, sourceSnapshotId)
+				if err != nil {
+					return err
+				}
 				postBody["source_snapshot_id"] = sourceSnapshotId
 			}
 
+			if dryRun {
+				return output.PrintDryRun(cmd, cfg, http.MethodPost, path, postBody, nil)
+			}
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:   http.MethodPost,
 				PostBody: postBody,
@@ -57,7 +77,9 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 			}
 
 			if statusCode == http.StatusAccepted {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id"}); err != nil {
+					return err
+				}
 			}
 
 			if await {
@@ -76,10 +98,16 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 
 	cmd.Flags().StringVar(&sourceInstanceId, sourceInstanceIdFlag, "", "The ID of the instance to overwrite with, from the source snapshot ID if provided, otherwise takes a new snapshot and overwrites")
 	cmd.Flags().StringVar(&sourceSnapshotId, sourceSnapshotIdFlag, "", "The ID of the snapshot to overwrite with, which must be exportable, from the source instance ID if provided, otherwise the argument provided instance")
+	completion.Flag(cmd, cfg, sourceSnapshotIdFlag, completion.Snapshot)
 
 	cmd.MarkFlagsOneRequired(sourceInstanceIdFlag, sourceSnapshotIdFlag)
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until created snapshot is ready")
 
+	cmd.Flags().BoolVar(&dryRun, dryRunFlag, false, "Prints the request without overwriting the instance.")
+
+	completion.Arg(cmd, cfg, completion.Instance)
+	completion.Flag(cmd, cfg, sourceInstanceIdFlag, completion.Instance)
+
 	return cmd
 }
@@ -94,6 +94,28 @@ func TestOverwriteFromSnapshot(t *testing.T) {
 	}`)
 }
 
+func TestOverwriteFromSnapshotIdPrefix(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances/191b0da2/snapshots", http.StatusOK, `{
+		"data": [
+			{"snapshot_id": "3e5e6e27-bf0a-4898-abb8-5f3050cac418", "instance_id": "191b0da2", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z", "exportable": true}
+		]
+	}`)
+	postMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/overwrite", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "overwriting"}
+	}`)
+
+	helper.ExecuteCommand("instance overwrite 2f49c2b3 --source-instance-id 191b0da2 --source-snapshot-id 3e5e")
+
+	listMock.AssertCalledTimes(1)
+	postMock.AssertCalledTimes(1)
+	postMock.AssertCalledWithBody(`{
+		"source_instance_id": "191b0da2","source_snapshot_id": "3e5e6e27-bf0a-4898-abb8-5f3050cac418"
+	}`)
+}
+
 func TestOverwriteWithAwait(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -155,3 +177,25 @@ Waiting for instance to be ready...
 Instance Status: ready
 	  `)
 }
+
+func TestOverwriteDryRun(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+	sourceId := "191b0da2"
+
+	postMock := helper.NewRequestHandlerMock(fmt.Sprintf("POST /v1/instances/%s/overwrite", instanceId), http.StatusAccepted, "")
+
+	helper.ExecuteCommand(fmt.Sprintf("instance overwrite %s --source-instance-id %s --dry-run", instanceId, sourceId))
+
+	postMock.AssertCalledTimes(0)
+
+	helper.AssertOutJson(`{
+		"method": "POST",
+		"path": "/instances/2f49c2b3/overwrite",
+		"body": {
+			"source_instance_id": "191b0da2"
+		}
+	}`)
+}
@@ -6,13 +6,23 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewPauseCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
-		Use:   "pause <id>",
+	var (
+		await bool
+		bulk  bulkOptions
+	)
+
+	const (
+		awaitFlag = "await"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pause [id]",
 		Short: "Pauses an instance",
 		Long: `Starts the pause process of an Aura instance.
 
@@ -20,12 +30,34 @@ Pausing an instance is an asynchronous operation. You can poll the current statu
 
 The pause time depends on the amount of data stored in the instance; larger quantities of data will take longer. The exact time this will take is dependent on the size of your data store.
 
-If another operation is being performed on the instance you are trying to pause, an error will be returned that indicates that the pause operation cannot be performed.`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s/pause", args[0])
+If another operation is being performed on the instance you are trying to pause, an error will be returned that indicates that the pause operation cannot be performed.
 
+` + bulkHelp,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return bulk.validate(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			if bulk.isSet() {
+				instances, err := bulk.selectInstances(cfg)
+				if err != nil {
+					return err
+				}
+				return runBulk(cmd, cfg, &bulk, instances, func(instanceId string) ([]byte, error) {
+					resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/pause", instanceId), &api.RequestConfig{
+						Method: http.MethodPost,
+					})
+					return resBody, err
+				}, await, api.InstanceStatusPausing)
+			}
+
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/pause", instanceId)
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
@@ -35,9 +67,28 @@ If another operation is being performed on the instance you are trying to pause,
 
 			// NOTE: Instance pause should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "tenant_id", "connection_url", "cloud_provider", "region", "type", "memory"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "tenant_id", "connection_url", "cloud_provider", "region", "type", "memory"}); err != nil {
+					return err
+				}
+
+				if await {
+					cmd.Println("Waiting for instance to be paused...")
+					pollResponse, err := api.PollInstance(cfg, instanceId, api.InstanceStatusPausing)
+					if err != nil {
+						return err
+					}
+
+					cmd.Println("Instance Status:", pollResponse.Data.Status)
+				}
 			}
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until paused instance is paused.")
+	addBulkFlags(cmd, cfg, &bulk)
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
+	return cmd
 }
@@ -99,3 +99,102 @@ func TestPauseInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseInstancesByNameGlob(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51f0e6c", "name": "dev-two", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "a1b2c3d4", "name": "production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	pauseMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3/pause", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "pausing"}
+	}`)
+	failingPauseMock := helper.NewRequestHandlerMock("/v1/instances/b51f0e6c/pause", http.StatusConflict, `{
+		"errors": [
+			{
+				"message": "The database is current undergoing an operation: resuming",
+				"reason": "ongoing-database-operation"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance pause --name-glob dev-* --rate-limit 0")
+
+	listMock.AssertCalledTimes(1)
+	pauseMock.AssertCalledTimes(1)
+	pauseMock.AssertCalledWithMethod(http.MethodPost)
+	failingPauseMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"error": "",
+				"id": "2f49c2b3",
+				"name": "dev-one",
+				"status": "pausing"
+			},
+			{
+				"error": "[The database is current undergoing an operation: resuming]",
+				"id": "b51f0e6c",
+				"name": "dev-two",
+				"status": ""
+			}
+		]
+	}`)
+	helper.AssertErr("Error: 1 of 2 instances failed\n")
+}
+
+func TestPauseInstancesByStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51f0e6c", "name": "dev-two", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "running", "type": "enterprise-db"}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51f0e6c", http.StatusOK, `{
+		"data": {"id": "b51f0e6c", "name": "dev-two", "status": "paused", "type": "enterprise-db"}
+	}`)
+	pauseMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3/pause", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "pausing"}
+	}`)
+
+	helper.ExecuteCommand("instance pause --status running --rate-limit 0 --output table")
+
+	pauseMock.AssertCalledTimes(1)
+
+	helper.AssertOut(`┌──────────┬─────────┬─────────┬───────┐
+│ ID       │ NAME    │ STATUS  │ ERROR │
+├──────────┼─────────┼─────────┼───────┤
+│ 2f49c2b3 │ dev-one │ pausing │       │
+└──────────┴─────────┴─────────┴───────┘
+`)
+}
+
+func TestPauseInstanceWithIdAndSelectors(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance pause 2f49c2b3 --all")
+
+	helper.AssertErr("Error: an instance ID cannot be used together with the --all, --tenant-id, --name-glob, --type or --status flags\n")
+}
+
+func TestPauseInstanceWithoutIdOrSelectors(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance pause")
+
+	helper.AssertErr("Error: requires an instance ID, or at least one of the --all, --tenant-id, --name-glob, --type or --status flags\n")
+}
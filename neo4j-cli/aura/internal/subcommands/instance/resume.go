@@ -7,6 +7,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -14,6 +15,7 @@ import (
 func NewResumeCmd(cfg *clicfg.Config) *cobra.Command {
 	var (
 		await bool
+		bulk  bulkOptions
 	)
 
 	const (
@@ -21,18 +23,40 @@ func NewResumeCmd(cfg *clicfg.Config) *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "resume <id>",
+		Use:   "resume [id]",
 		Short: "Resumes an instance",
 		Long: `Starts the resume process of an Aura instance.
 
 Resuming an instance is an asynchronous operation. You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand.
 
-If another operation is being performed on the instance you are trying to resume, an error will be returned that indicates that resume cannot be performed.`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s/resume", args[0])
+If another operation is being performed on the instance you are trying to resume, an error will be returned that indicates that resume cannot be performed.
 
+` + bulkHelp,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return bulk.validate(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			if bulk.isSet() {
+				instances, err := bulk.selectInstances(cfg)
+				if err != nil {
+					return err
+				}
+				return runBulk(cmd, cfg, &bulk, instances, func(instanceId string) ([]byte, error) {
+					resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/resume", instanceId), &api.RequestConfig{
+						Method: http.MethodPost,
+					})
+					return resBody, err
+				}, await, api.InstanceStatusResuming)
+			}
+
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/resume", instanceId)
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
@@ -42,7 +66,9 @@ If another operation is being performed on the instance you are trying to resume
 
 			// NOTE: Instance resume should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for instance to be ready...")
@@ -64,5 +90,9 @@ If another operation is being performed on the instance you are trying to resume
 	}
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until resumed instance is ready.")
+	addBulkFlags(cmd, cfg, &bulk)
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
 	return cmd
 }
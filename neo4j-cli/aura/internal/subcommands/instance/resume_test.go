@@ -99,3 +99,73 @@ func TestResumeInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeInstancesOfTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "e3e8a0b2-7a1c-4a60-9c3e-2d7e1f0a5b6c"
+
+	listMock := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-one", "tenant_id": "e3e8a0b2-7a1c-4a60-9c3e-2d7e1f0a5b6c", "cloud_provider": "gcp"},
+			{"id": "b51f0e6c", "name": "dev-two", "tenant_id": "e3e8a0b2-7a1c-4a60-9c3e-2d7e1f0a5b6c", "cloud_provider": "gcp"}
+		]
+	}`)
+	firstMock := helper.NewRequestHandlerMock("/v1/instances/2f49c2b3/resume", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "dev-one", "status": "resuming"}
+	}`)
+	secondMock := helper.NewRequestHandlerMock("/v1/instances/b51f0e6c/resume", http.StatusAccepted, `{
+		"data": {"id": "b51f0e6c", "name": "dev-two", "status": "resuming"}
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance resume --tenant-id %s --concurrency 1 --rate-limit 0", tenantId))
+
+	listMock.AssertCalledTimes(1)
+	listMock.AssertCalledWithQueryParam("tenantId", tenantId)
+	firstMock.AssertCalledTimes(1)
+	firstMock.AssertCalledWithMethod(http.MethodPost)
+	secondMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"error": "",
+				"id": "2f49c2b3",
+				"name": "dev-one",
+				"status": "resuming"
+			},
+			{
+				"error": "",
+				"id": "b51f0e6c",
+				"name": "dev-two",
+				"status": "resuming"
+			}
+		]
+	}`)
+}
+
+func TestResumeInstancesWithNoMatch(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance resume --name-glob dev-*")
+
+	helper.AssertOut("No instances match the selectors\n")
+}
+
+func TestResumeInstancesWithInvalidConcurrency(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance resume --all --concurrency 0")
+
+	helper.AssertErr(`Error: invalid argument "0" for "--concurrency" flag: must be at least 1
+`)
+}
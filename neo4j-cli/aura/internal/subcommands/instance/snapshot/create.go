@@ -7,6 +7,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +24,11 @@ Creating a snapshot is an asynchronous operation. You can poll the current statu
 The time taken to complete a snapshot depends on the amount of data stored in the instance; larger quantities of data will take longer. The exact time this will take is dependent on the size of your data store.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/snapshots", instanceId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
@@ -34,7 +40,9 @@ The time taken to complete a snapshot depends on the amount of data stored in th
 			}
 
 			if statusCode == http.StatusAccepted {
-				output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id"}); err != nil {
+					return err
+				}
 
 				if await {
 					cmd.Println("Waiting for snapshot to be ready...")
@@ -57,6 +65,7 @@ The time taken to complete a snapshot depends on the amount of data stored in th
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance to create a snapshot of")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until created snapshot is ready.")
@@ -40,3 +40,57 @@ func TestGetSnapshot(t *testing.T) {
 		}
 	}`)
 }
+
+func TestGetSnapshotByIdPrefix(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{
+		"data": [
+			{"snapshot_id": "afdb4e9d-6ba6-4d45-b951-f82843dcbca6", "instance_id": "2f49c2b3", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"},
+			{"snapshot_id": "b1c2d3e4-6ba6-4d45-b951-f82843dcbca6", "instance_id": "2f49c2b3", "profile": "Scheduled", "status": "Completed", "timestamp": "2024-09-12T14:51:45Z"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/afdb4e9d-6ba6-4d45-b951-f82843dcbca6", http.StatusOK, `{
+		"data": {"snapshot_id": "afdb4e9d-6ba6-4d45-b951-f82843dcbca6", "instance_id": "2f49c2b3", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"}
+	}`)
+
+	helper.ExecuteCommand("instance snapshot get --instance-id 2f49c2b3 afdb -q")
+
+	listMock.AssertCalledTimes(1)
+	getMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut("afdb4e9d-6ba6-4d45-b951-f82843dcbca6")
+}
+
+func TestGetSnapshotCompletesIds(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{
+		"data": [
+			{"snapshot_id": "afdb4e9d", "instance_id": "2f49c2b3", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"},
+			{"snapshot_id": "1a2b3c4d", "instance_id": "2f49c2b3", "profile": "Scheduled", "status": "Completed", "timestamp": "2024-09-11T13:51:45Z"}
+		]
+	}`)
+
+	helper.ExecuteCommand("__complete instance snapshot get --instance-id 2f49c2b3 ''")
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertOut(`afdb4e9d	2024-09-12T13:51:45Z
+1a2b3c4d	2024-09-11T13:51:45Z
+:4`)
+}
+
+func TestGetSnapshotCompletesInstanceFlagWithoutInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("__complete instance snapshot get ''")
+
+	mockHandler.AssertCalledTimes(0)
+	helper.AssertOut(`--instance-id	The ID of the instance to get the snapshot details of
+:4`)
+}
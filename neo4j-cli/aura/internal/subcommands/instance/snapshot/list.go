@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -13,13 +14,21 @@ import (
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 	var instanceId string
 	var date string
+	var listOptions output.ListOptions
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of snapshots",
-		Long:  `This subcommand returns a list of available snapshots from the current day.`,
+		Long: `This subcommand returns a list of available snapshots from the current day.
+
+The listed snapshots can be filtered with --filter, such as --filter 'status=completed,profile=AdHoc', and sorted with --sort, such as --sort -timestamp.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			var err error
+			instanceId, err = api.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/snapshots", instanceId)
 			var queryParams map[string]string
 			if date != "" {
@@ -35,15 +44,22 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id", "instance_id", "profile", "status", "timestamp"})
+				if err := output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.ParseBody(resBody)), []string{"snapshot_id", "instance_id", "profile", "status", "timestamp"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance to list the snapshots of")
+	completion.Flag(cmd, cfg, "instance-id", completion.Instance)
 	cmd.MarkFlagRequired("instance-id")
 	cmd.Flags().StringVar(&date, "date", "", "An optional date to list snapshots for a given day, defaults to today. Must be formatted with an ISO formatted date string (YYYY-MM-DD)")
 
+	output.AddListFlags(cmd, &listOptions)
+
+	output.AddWatchFlags(cmd)
+
 	return cmd
 }
@@ -82,3 +82,66 @@ func TestListSnapshotWithDate(t *testing.T) {
 	}
 	`)
 }
+
+func TestListSnapshotWithFilterAndSort(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s/snapshots", instanceId), http.StatusOK, `{
+		"data": [
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "AdHoc",
+				"snapshot_id": "afdb4e9d",
+				"status": "Completed",
+				"timestamp": "2024-09-12T10:51:45Z"
+			},
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "Scheduled",
+				"snapshot_id": "b8a3c2e1",
+				"status": "Completed",
+				"timestamp": "2024-09-12T13:51:45Z"
+			},
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "AdHoc",
+				"snapshot_id": "c91f0d44",
+				"status": "InProgress",
+				"timestamp": "2024-09-12T15:51:45Z"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance snapshot list --instance-id %s --filter status=completed --sort -timestamp --output csv", instanceId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`snapshot_id,instance_id,profile,status,timestamp
+b8a3c2e1,2f49c2b3,Scheduled,Completed,2024-09-12T13:51:45Z
+afdb4e9d,2f49c2b3,AdHoc,Completed,2024-09-12T10:51:45Z`)
+}
+
+func TestListSnapshotQuietly(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s/snapshots", instanceId), http.StatusOK, `{
+		"data": [
+			{
+				"instance_id": "2f49c2b3",
+				"snapshot_id": "afdb4e9d",
+				"status": "Completed"
+			},
+			{
+				"instance_id": "2f49c2b3",
+				"snapshot_id": "b8a3c2e1",
+				"status": "Completed"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance snapshot list --instance-id %s -q", instanceId))
+
+	helper.AssertErr("")
+	helper.AssertOut("afdb4e9d\nb8a3c2e1")
+}
@@ -5,20 +5,29 @@ import (
 	"net/http"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/cost"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
 func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 	var (
-		memory string
-		name   string
+		memory    string
+		name      string
+		dryRun    bool
+		estimate  bool
+		priceFile string
 	)
 
 	const (
-		memoryFlag = "memory"
-		nameFlag   = "name"
+		memoryFlag    = "memory"
+		nameFlag      = "name"
+		dryRunFlag    = "dry-run"
+		estimateFlag  = "estimate"
+		priceFileFlag = "price-file"
 	)
 
 	cmd := &cobra.Command{
@@ -26,9 +35,19 @@ func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Updates an instance",
 		Long: `This command allows you to rename and/or resize an Aura instance.
 
-Resizing an instance is an asynchronous operation. The instance remains available throughout.`,
+Resizing an instance is an asynchronous operation. The instance remains available throughout. The new memory is checked against the instance configurations of the tenant before the instance is resized.
+
+Use --dry-run to print the request that would be sent along with the fields it would change, without updating the instance.
+
+Use --estimate with --memory to print the cost of the instance before and after resizing it, without updating the instance. See the cost estimate subcommand for where prices are read from.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
 			body := map[string]any{}
 
 			if memory != "" {
@@ -39,9 +58,45 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 				body["name"] = name
 			}
 
-			path := fmt.Sprintf("/instances/%s", args[0])
+			path := fmt.Sprintf("/instances/%s", instanceId)
+
+			if estimate {
+				if memory == "" {
+					return clierr.NewUsageError("the --%s flag requires the --%s flag", estimateFlag, memoryFlag)
+				}
+				current, err := api.GetInstance(cfg, instanceId)
+				if err != nil {
+					return err
+				}
+				delta, err := estimateResize(cfg, current, memory, priceFile)
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(delta), []string{"id", "name", "memory", "new_memory", "hourly_cost", "new_hourly_cost", "hourly_delta", "monthly_cost", "new_monthly_cost", "monthly_delta", "currency"})
+			}
+
+			var current map[string]any
+			if dryRun || memory != "" {
+				current, err = api.GetInstance(cfg, instanceId)
+				if err != nil {
+					return err
+				}
+			}
+
+			// A dry run reports an unavailable memory as an update would
+			if memory != "" {
+				tenantId, _ := current["tenant_id"].(string)
+				configuration := cost.InstanceConfiguration(current)
+				configuration.Memory = memory
+				if err := api.ValidateInstanceConfiguration(cfg, tenantId, configuration); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				return output.PrintDryRun(cmd, cfg, http.MethodPatch, path, body, current)
+			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:   http.MethodPatch,
 				PostBody: body,
@@ -51,7 +106,9 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 			}
 
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
@@ -63,5 +120,45 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 
 	cmd.MarkFlagsOneRequired(memoryFlag, nameFlag)
 
+	cmd.Flags().BoolVar(&dryRun, dryRunFlag, false, "Prints the request and the resulting changes without updating the instance.")
+	cmd.Flags().BoolVar(&estimate, estimateFlag, false, "Prints the cost of the instance before and after resizing it without updating the instance.")
+	cmd.Flags().StringVar(&priceFile, priceFileFlag, "", "A YAML or JSON file with prices, used with --estimate for instance configurations without a price")
+
+	completion.Arg(cmd, cfg, completion.Instance)
+
 	return cmd
 }
+
+// The cost of an instance with its current memory and with the new memory, and the difference
+func estimateResize(cfg *clicfg.Config, instance map[string]any, memory string, priceFile string) (map[string]any, error) {
+	tenantId, _ := instance["tenant_id"].(string)
+	pricing, err := cost.NewPricing(cfg, tenantId, priceFile)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := cost.InstanceConfiguration(instance)
+	current, err := pricing.EstimateOrError(configuration)
+	if err != nil {
+		return nil, err
+	}
+	configuration.Memory = memory
+	resized, err := pricing.EstimateOrError(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"id":               instance["id"],
+		"name":             instance["name"],
+		"memory":           current["memory"],
+		"new_memory":       memory,
+		"hourly_cost":      current["hourly_cost"],
+		"new_hourly_cost":  resized["hourly_cost"],
+		"hourly_delta":     cost.Round(resized["hourly_cost"].(float64) - current["hourly_cost"].(float64)),
+		"monthly_cost":     current["monthly_cost"],
+		"new_monthly_cost": resized["monthly_cost"],
+		"monthly_delta":    cost.Round(resized["monthly_cost"].(float64) - current["monthly_cost"].(float64)),
+		"currency":         pricing.Currency(),
+	}, nil
+}
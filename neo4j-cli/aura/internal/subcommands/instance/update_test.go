@@ -14,7 +14,19 @@ func TestUpdateMemory(t *testing.T) {
 
 	instanceId := "2f49c2b3"
 
-	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusAccepted, `{
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"memory": "16GB",
+			"region": "europe-west1",
+			"type": "enterprise-db"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s", instanceId), http.StatusAccepted, `{
 		"data": {
 			"id": "2f49c2b3",
 			"name": "Production",
@@ -96,7 +108,19 @@ func TestUpdateMemoryAndName(t *testing.T) {
 
 	instanceId := "2f49c2b3"
 
-	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusAccepted, `{
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"memory": "16GB",
+			"region": "europe-west1",
+			"type": "enterprise-db"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s", instanceId), http.StatusAccepted, `{
 		"data": {
 			"id": "2f49c2b3",
 			"name": "New Name",
@@ -186,7 +210,19 @@ func TestUpdateInstanceError(t *testing.T) {
 
 			instanceId := "2f49c2b3"
 
-			mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), testCase.statusCode, testCase.returnBody)
+			helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, `{
+				"data": {
+					"id": "2f49c2b3",
+					"name": "Production",
+					"tenant_id": "YOUR_TENANT_ID",
+					"cloud_provider": "gcp",
+					"memory": "16GB",
+					"region": "europe-west1",
+					"type": "enterprise-db"
+				}
+			}`)
+			helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+			mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s", instanceId), testCase.statusCode, testCase.returnBody)
 
 			helper.ExecuteCommand(fmt.Sprintf(`instance update %s --name "New Name" --memory 8GB`, instanceId))
 
@@ -198,3 +234,248 @@ func TestUpdateInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateMemoryDryRun(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	getMock := helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}}`)
+	patchMock := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s", instanceId), http.StatusAccepted, "")
+
+	helper.ExecuteCommand(fmt.Sprintf(`instance update %s --memory 8GB --name Production --dry-run`, instanceId))
+
+	getMock.AssertCalledTimes(1)
+	patchMock.AssertCalledTimes(0)
+
+	helper.AssertOutJson(`{
+		"method": "PATCH",
+		"path": "/instances/2f49c2b3",
+		"body": {
+			"memory": "8GB",
+			"name": "Production"
+		},
+		"diff": [
+			{
+				"after": "8GB",
+				"before": "4GB",
+				"field": "memory"
+			}
+		]
+	}`)
+}
+
+func TestUpdateMemoryDryRunWithTableOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	getMock := helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}}`)
+	patchMock := helper.NewRequestHandlerMock(fmt.Sprintf("PATCH /v1/instances/%s", instanceId), http.StatusAccepted, "")
+
+	helper.ExecuteCommand(fmt.Sprintf(`instance update %s --memory 8GB --dry-run --output table`, instanceId))
+
+	getMock.AssertCalledTimes(1)
+	patchMock.AssertCalledTimes(0)
+
+	helper.AssertOut(`
+Dry run, no request has been sent
+PATCH /instances/2f49c2b3
+{
+	"memory": "8GB"
+}
+┌────────┬────────┬───────┐
+│ FIELD  │ BEFORE │ AFTER │
+├────────┼────────┼───────┤
+│ memory │ 4GB    │ 8GB   │
+└────────┴────────┴───────┘
+`)
+}
+
+func TestUpdateInstanceEstimate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "orders",
+			"tenant_id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "8GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/6981ace7-efe8-4f5c-b7c5-267b5162ce91", http.StatusOK, `{
+		"data": {
+			"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"name": "Production",
+			"instance_configurations": []
+		}
+	}`)
+	helper.SetFile("prices.yaml", `prices:
+  - type: enterprise-db
+    memory: 8GB
+    price_per_hour: 0.8
+  - type: enterprise-db
+    memory: 16GB
+    price_per_hour: 1.6
+`)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 16GB --estimate --price-file prices.yaml")
+
+	updateMock.AssertCalledTimes(0)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"currency": "USD",
+			"hourly_cost": 0.8,
+			"hourly_delta": 0.8,
+			"id": "2f49c2b3",
+			"memory": "8GB",
+			"monthly_cost": 584,
+			"monthly_delta": 584,
+			"name": "orders",
+			"new_hourly_cost": 1.6,
+			"new_memory": "16GB",
+			"new_monthly_cost": 1168
+		}
+	}`)
+}
+
+func TestUpdateInstanceEstimateWithoutMemory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --name new-name --estimate")
+
+	helper.AssertErr("Error: the --estimate flag requires the --memory flag\n")
+}
+
+func TestUpdateWithUnavailableMemory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"memory": "16GB",
+			"region": "europe-west1",
+			"type": "enterprise-db"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 24GB")
+
+	updateMock.AssertCalledTimes(0)
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: memory 24GB is not available for enterprise-db instances in europe-west1 in tenant YOUR_TENANT_ID, the nearest memory sizes are 8GB, 16GB, 64GB\n")
+}
+
+func TestUpdateDryRunWithUnavailableMemory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"memory": "16GB",
+			"region": "europe-west1",
+			"type": "enterprise-db"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithInstanceConfigurations)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 24GB --dry-run")
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: memory 24GB is not available for enterprise-db instances in europe-west1 in tenant YOUR_TENANT_ID, the nearest memory sizes are 8GB, 16GB, 64GB\n")
+}
+
+func TestUpdateMemoryDryRunWithYamlOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, "")
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 8GB --dry-run --output yaml")
+
+	patchMock.AssertCalledTimes(0)
+
+	helper.AssertErr("")
+	helper.AssertOut(`body:
+  memory: 8GB
+diff:
+  - after: 8GB
+    before: 4GB
+    field: memory
+method: PATCH
+path: /instances/2f49c2b3
+`)
+}
+
+func TestUpdateMemoryDryRunWithCsvOutputPrintsOnlyChanges(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, "")
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 8GB --dry-run --output csv")
+
+	patchMock.AssertCalledTimes(0)
+
+	helper.AssertErr("")
+	helper.AssertOut(`field,before,after
+memory,4GB,8GB`)
+}
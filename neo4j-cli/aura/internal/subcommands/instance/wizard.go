@@ -0,0 +1,319 @@
+package instance
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/cost"
+)
+
+var shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@=-]+$`)
+
+// The values an instance is created with, empty when not given yet
+type createValues struct {
+	tenantId             string
+	name                 string
+	version              string
+	instanceType         string
+	cloudProvider        string
+	region               string
+	memory               string
+	customerManagedKeyId string
+}
+
+// An answer to choose from, with the label shown for it
+type choice struct {
+	value string
+	label string
+}
+
+// Asks questions on the input of a command, reading one answer per line
+type prompter struct {
+	cmd    *cobra.Command
+	reader *bufio.Reader
+}
+
+func newPrompter(cmd *cobra.Command) *prompter {
+	return &prompter{cmd: cmd, reader: bufio.NewReader(cmd.InOrStdin())}
+}
+
+func (p *prompter) readLine(subject string) (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", clierr.NewUsageError("no %s was given, the instance has not been created", subject)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// Asks for a value until a non-empty one is given
+func (p *prompter) ask(subject string) (string, error) {
+	for {
+		p.cmd.Printf("Enter the %s: ", subject)
+		answer, err := p.readLine(subject)
+		if err != nil || answer != "" {
+			return answer, err
+		}
+	}
+}
+
+// Asks to choose one of the choices by number, choosing the only choice without asking
+func (p *prompter) choose(subject string, choices []choice) (string, error) {
+	if len(choices) == 1 {
+		p.cmd.Printf("Only one %s is available: %s\n", subject, choices[0].label)
+		return choices[0].value, nil
+	}
+	p.cmd.Printf("Choose the %s:\n", subject)
+	for i, c := range choices {
+		p.cmd.Printf("\t%d) %s\n", i+1, c.label)
+	}
+	for {
+		p.cmd.Printf("Enter a number from 1 to %d: ", len(choices))
+		answer, err := p.readLine(subject)
+		if err != nil {
+			return "", err
+		}
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(choices) {
+			return choices[number-1].value, nil
+		}
+	}
+}
+
+// Asks for the values that are not given yet, choosing from the instance configurations of the tenant,
+// then prints a summary with the estimated cost and the equivalent command line.
+// Returns true if the instance should be created.
+func runCreateWizard(cmd *cobra.Command, cfg *clicfg.Config, values *createValues, estimate bool, priceFile string) (bool, error) {
+	p := newPrompter(cmd)
+
+	if values.tenantId == "" {
+		tenants, err := api.ListTenants(cfg)
+		if err != nil {
+			return false, err
+		}
+		if len(tenants) == 0 {
+			return false, clierr.NewUsageError("no tenant is available to create an instance in")
+		}
+		choices := []choice{}
+		for _, tenant := range tenants {
+			id, _ := tenant["id"].(string)
+			choices = append(choices, choice{value: id, label: fmt.Sprintf("%s (%s)", tenant["name"], id)})
+		}
+		if values.tenantId, err = p.choose("tenant", choices); err != nil {
+			return false, err
+		}
+	}
+
+	configurations, err := api.GetInstanceConfigurations(cfg, values.tenantId)
+	if err != nil {
+		return false, err
+	}
+	if len(configurations) == 0 {
+		return false, clierr.NewUsageError("tenant %s has no instance configurations to choose from", values.tenantId)
+	}
+
+	if values.instanceType, err = chooseConfigurationValue(p, &configurations, "type", "instance type", values.instanceType); err != nil {
+		return false, err
+	}
+	if values.instanceType != "free-db" {
+		if values.cloudProvider, err = chooseConfigurationValue(p, &configurations, "cloud_provider", "cloud provider", values.cloudProvider); err != nil {
+			return false, err
+		}
+		if values.region, err = chooseConfigurationValue(p, &configurations, "region", "region", values.region); err != nil {
+			return false, err
+		}
+		if values.memory, err = chooseConfigurationValue(p, &configurations, "memory", "memory", values.memory); err != nil {
+			return false, err
+		}
+		if versions := configurationChoices(configurations, "version"); len(versions) > 0 && !slices.ContainsFunc(versions, func(c choice) bool { return c.value == values.version }) {
+			if values.version, err = p.choose("Neo4j version", versions); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if values.name == "" && !estimate {
+		if values.name, err = p.ask("name of the instance"); err != nil {
+			return false, err
+		}
+	}
+
+	if values.customerManagedKeyId == "" && values.instanceType != "free-db" {
+		cmks, err := readyCMKs(cfg, values)
+		if err != nil {
+			return false, err
+		}
+		if len(cmks) > 0 {
+			choices := []choice{{value: "", label: "No customer managed key"}}
+			for _, cmk := range cmks {
+				id, _ := cmk["id"].(string)
+				choices = append(choices, choice{value: id, label: fmt.Sprintf("%s (%s)", cmk["name"], id)})
+			}
+			if values.customerManagedKeyId, err = p.choose("customer managed key", choices); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	printCreateSummary(cmd, cfg, values, priceFile)
+
+	if estimate {
+		return false, nil
+	}
+	cmd.Print("Do you want to create this instance? Only 'yes' will be accepted: ")
+	answer, _ := p.reader.ReadString('\n')
+	if strings.TrimSpace(answer) != "yes" {
+		cmd.Println("Create cancelled")
+		return false, nil
+	}
+	return true, nil
+}
+
+// Chooses a value of the configurations unless it is already given, then keeps the configurations with that value
+func chooseConfigurationValue(p *prompter, configurations *[]map[string]any, key string, subject string, value string) (string, error) {
+	if value == "" {
+		var err error
+		if value, err = p.choose(subject, configurationChoices(*configurations, key)); err != nil {
+			return "", err
+		}
+	}
+	matching := []map[string]any{}
+	for _, configuration := range *configurations {
+		if configurationValue, _ := configuration[key].(string); strings.EqualFold(configurationValue, value) {
+			matching = append(matching, configuration)
+		}
+	}
+	if len(matching) == 0 {
+		return "", clierr.NewUsageError("%s %s is not available in the instance configurations of the tenant", subject, value)
+	}
+	*configurations = matching
+	return value, nil
+}
+
+// The distinct values of the configurations, with region names and storage sizes in their labels
+func configurationChoices(configurations []map[string]any, key string) []choice {
+	choices := []choice{}
+	for _, configuration := range configurations {
+		value, _ := configuration[key].(string)
+		if value == "" || slices.ContainsFunc(choices, func(c choice) bool { return c.value == value }) {
+			continue
+		}
+		label := value
+		if regionName, _ := configuration["region_name"].(string); key == "region" && regionName != "" {
+			label = fmt.Sprintf("%s (%s)", value, regionName)
+		}
+		if storage, _ := configuration["storage"].(string); key == "memory" && storage != "" {
+			label = fmt.Sprintf("%s memory, %s storage", value, storage)
+		}
+		choices = append(choices, choice{value: value, label: label})
+	}
+	slices.SortFunc(choices, func(a choice, b choice) int {
+		if key == "memory" {
+			sizeA, _ := api.MemoryInGB(a.value)
+			sizeB, _ := api.MemoryInGB(b.value)
+			return cmp.Compare(sizeA, sizeB)
+		}
+		return strings.Compare(a.value, b.value)
+	})
+	return choices
+}
+
+// The ready customer managed keys of the tenant that can encrypt the instance
+func readyCMKs(cfg *clicfg.Config, values *createValues) ([]map[string]any, error) {
+	cmks, err := api.ListCMKs(cfg, values.tenantId)
+	if err != nil {
+		return nil, err
+	}
+	ready := []map[string]any{}
+	for _, cmk := range cmks {
+		id, _ := cmk["id"].(string)
+		details, err := api.GetCMK(cfg, id)
+		if err != nil {
+			return nil, err
+		}
+		if details["status"] != "ready" ||
+			!matchesIfSet(details, "cloud_provider", values.cloudProvider) ||
+			!matchesIfSet(details, "region", values.region) ||
+			!matchesIfSet(details, "type", values.instanceType) {
+			continue
+		}
+		ready = append(ready, details)
+	}
+	return ready, nil
+}
+
+func matchesIfSet(resource map[string]any, key string, value string) bool {
+	resourceValue, _ := resource[key].(string)
+	return resourceValue == "" || strings.EqualFold(resourceValue, value)
+}
+
+func printCreateSummary(cmd *cobra.Command, cfg *clicfg.Config, values *createValues, priceFile string) {
+	configuration := api.InstanceConfiguration{Type: values.instanceType, CloudProvider: values.cloudProvider, Region: values.region, Memory: values.memory}
+	var estimatedCost string
+	if values.instanceType == "free-db" {
+		estimatedCost = "free"
+	} else if pricing, err := cost.NewPricing(cfg, values.tenantId, priceFile); err != nil {
+		estimatedCost = fmt.Sprintf("unknown, %s", err)
+	} else if estimate, err := pricing.EstimateOrError(configuration); err != nil {
+		estimatedCost = fmt.Sprintf("unknown, %s", err)
+	} else {
+		estimatedCost = fmt.Sprintf("%.2f %s per hour, %.2f %s per month", estimate["hourly_cost"], pricing.Currency(), estimate["monthly_cost"], pricing.Currency())
+	}
+
+	cmd.Println("Summary")
+	for _, line := range [][2]string{
+		{"Tenant", values.tenantId},
+		{"Name", values.name},
+		{"Type", values.instanceType},
+		{"Version", values.version},
+		{"Cloud provider", values.cloudProvider},
+		{"Region", values.region},
+		{"Memory", values.memory},
+		{"Customer managed key", values.customerManagedKeyId},
+		{"Estimated cost", estimatedCost},
+	} {
+		if line[1] != "" {
+			cmd.Printf("\t%-22s%s\n", line[0]+":", line[1])
+		}
+	}
+
+	cmd.Println("Equivalent command")
+	cmd.Printf("\t%s\n", createCommandLine(cmd, values))
+}
+
+// The command line that creates the instance without asking any question
+func createCommandLine(cmd *cobra.Command, values *createValues) string {
+	args := []string{cmd.CommandPath()}
+	for _, flag := range [][2]string{
+		{"tenant-id", values.tenantId},
+		{"name", values.name},
+		{"type", values.instanceType},
+		{"version", values.version},
+		{"cloud-provider", values.cloudProvider},
+		{"region", values.region},
+		{"memory", values.memory},
+		{"customer-managed-key-id", values.customerManagedKeyId},
+	} {
+		if flag[1] == "" || flag[0] == "version" && values.instanceType == "free-db" {
+			continue
+		}
+		args = append(args, "--"+flag[0], shellQuote(flag[1]))
+	}
+	return strings.Join(args, " ")
+}
+
+func shellQuote(value string) string {
+	if shellSafePattern.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,83 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/declarative"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		file  string
+		prune bool
+	)
+
+	const (
+		fileFlag  = "file"
+		pruneFlag = "prune"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Shows the changes needed for your Aura resources to match a spec",
+		Long: `This command compares the desired state of your Aura resources, declared in a spec, with their live state and shows the changes that the apply command would make. No changes are made.
+
+The spec is read from a YAML or JSON file, or from every such file below a directory. Each document declares one resource with a kind of Instance, CustomerManagedKey or GraphQLDataApi. Resources are matched with live resources by name, within their tenant or instance.
+
+With --prune, the plan also shows the deletion of live resources that are not declared in the spec, which apply --prune would make. Only kinds of resources present in the spec are pruned, within the tenants and instances the spec declares resources in.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			if err := output.ValidateOutput(cmd.Flags().Lookup("output").Value.String()); err != nil {
+				return err
+			}
+
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			spec, err := declarative.Load(cfg.Aura.Fs(), file)
+			if err != nil {
+				return err
+			}
+
+			plan, err := declarative.NewPlan(cfg, spec, prune)
+			if err != nil {
+				return err
+			}
+
+			return PrintPlan(cmd, cfg, plan)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, fileFlag, "f", "", "(required) Path to a spec file, or a directory of spec files")
+	cmd.MarkFlagRequired(fileFlag)
+
+	cmd.Flags().BoolVar(&prune, pruneFlag, false, "Shows the deletion of live resources that are not declared in the spec")
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+	output.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+func PrintPlan(cmd *cobra.Command, cfg *clicfg.Config, plan *declarative.Plan) error {
+	if len(plan.Actions) == 0 && output.IsTable(cfg) {
+		cmd.Println("No changes, your Aura resources match the spec")
+		return nil
+	}
+
+	return output.PrintBodyMap(cmd, cfg, plan.AsResponseData(), []string{"action", "kind", "name", "parent", "changes"})
+}
@@ -0,0 +1,298 @@
+package plan_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const spec = `
+kind: CustomerManagedKey
+name: production-key
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: aws
+region: eu-west-1
+key_id: arn:aws:kms:eu-west-1:123456789:key/abc
+---
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: aws
+region: eu-west-1
+memory: 8GB
+customer_managed_key: production-key
+---
+kind: Instance
+name: Staging
+tenant_id: YOUR_TENANT_ID
+type: professional-db
+cloud_provider: gcp
+region: europe-west1
+memory: 2GB
+`
+
+func mockLiveResources(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{
+				"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+				"name": "production-key",
+				"tenant_id": "YOUR_TENANT_ID"
+			},
+			{
+				"id": "0b5ff5ce-b4d5-4ab8-8b44-4cb4a4acb4b1",
+				"name": "old-key",
+				"tenant_id": "YOUR_TENANT_ID"
+			}
+		]
+	}`).AddResponse(http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4", http.StatusOK, `{
+		"data": {
+			"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+			"name": "production-key",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "ready",
+			"cloud_provider": "aws",
+			"region": "eu-west-1",
+			"type": "enterprise-db",
+			"key_id": "arn:aws:kms:eu-west-1:123456789:key/abc"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "aws"
+			},
+			{
+				"id": "b51dc964",
+				"name": "Instance01",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"status": "running",
+			"cloud_provider": "aws",
+			"region": "eu-west-1",
+			"type": "enterprise-db",
+			"memory": "4GB",
+			"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4"
+		}
+	}`)
+}
+
+func TestPlan(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockLiveResources(&helper)
+	helper.SetFile("/specs/aura.yaml", spec)
+
+	helper.ExecuteCommand("plan -f /specs/aura.yaml")
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"action": "create",
+				"changes": "",
+				"kind": "Instance",
+				"name": "Staging",
+				"parent": "YOUR_TENANT_ID"
+			},
+			{
+				"action": "update",
+				"changes": "memory: 4GB → 8GB",
+				"kind": "Instance",
+				"name": "Production",
+				"parent": "YOUR_TENANT_ID"
+			}
+		]
+	}`)
+}
+
+func TestPlanWithPrune(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockLiveResources(&helper)
+	helper.SetFile("/specs/aura.yaml", spec)
+
+	helper.ExecuteCommand("plan -f /specs/aura.yaml --prune --output table")
+
+	helper.AssertOut(`
+┌────────┬────────────────────┬────────────┬────────────────┬───────────────────┐
+│ ACTION │ KIND               │ NAME       │ PARENT         │ CHANGES           │
+├────────┼────────────────────┼────────────┼────────────────┼───────────────────┤
+│ create │ Instance           │ Staging    │ YOUR_TENANT_ID │                   │
+│ update │ Instance           │ Production │ YOUR_TENANT_ID │ memory: 4GB → 8GB │
+│ delete │ Instance           │ Instance01 │ YOUR_TENANT_ID │                   │
+│ delete │ CustomerManagedKey │ old-key    │ YOUR_TENANT_ID │                   │
+└────────┴────────────────────┴────────────┴────────────────┴───────────────────┘
+`)
+}
+
+func TestPlanFromDirectoryWithDefaultTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.default-tenant", "YOUR_TENANT_ID")
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	instancesMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "b51dc964",
+				"name": "Staging",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {
+			"id": "b51dc964",
+			"name": "Staging",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "professional-db",
+			"memory": "2GB"
+		}
+	}`)
+	helper.SetFile("/specs/instances/staging.yaml", `
+kind: Instance
+name: Staging
+type: professional-db
+cloud_provider: gcp
+region: europe-west1
+memory: 2GB
+`)
+	helper.SetFile("/specs/README.md", "Not a spec")
+
+	helper.ExecuteCommand("plan -f /specs --output table")
+
+	instancesMock.AssertCalledWithQueryParam("tenantId", "YOUR_TENANT_ID")
+	helper.AssertOut("No changes, your Aura resources match the spec")
+}
+
+func TestPlanWithImmutableChange(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockLiveResources(&helper)
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: gcp
+region: europe-west1
+memory: 4GB
+`)
+
+	helper.ExecuteCommand("plan -f /specs/aura.yaml")
+
+	helper.AssertErr(`Error: the spec cannot be applied:
+	cloud_provider of Instance Production cannot be changed from aws to gcp
+	region of Instance Production cannot be changed from eu-west-1 to europe-west1`)
+}
+
+func TestPlanWithUnknownKind(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("/specs/aura.yaml", `
+kind: Database
+name: Production
+`)
+
+	helper.ExecuteCommand("plan -f /specs/aura.yaml")
+
+	helper.AssertErr("Error: unknown kind Database in spec file /specs/aura.yaml, must be one of Instance, CustomerManagedKey or GraphQLDataApi")
+}
+
+func TestPlanWithPruneKeepsAuthProvidersWhenNoneAreDeclared(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "Production",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "4GB"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "afdb4e9d",
+				"name": "movies",
+				"status": "ready"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "movies",
+			"status": "ready",
+			"type_definitions": "dHlwZSBNb3ZpZSB7IHRpdGxlOiBTdHJpbmcgfQ=="
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql/afdb4e9d/auth-providers", http.StatusOK, `{
+		"data": [
+			{
+				"id": "1ad1b794",
+				"name": "default",
+				"type": "api-key",
+				"enabled": true
+			}
+		]
+	}`)
+	helper.SetFile("/specs/aura.yaml", `
+kind: Instance
+name: Production
+tenant_id: YOUR_TENANT_ID
+type: enterprise-db
+cloud_provider: gcp
+region: europe-west1
+memory: 4GB
+---
+kind: GraphQLDataApi
+name: movies
+instance: Production
+type_definitions_file: movies.graphql
+`)
+	helper.SetFile("/specs/movies.graphql", "type Movie { title: String }")
+
+	helper.ExecuteCommand("plan -f /specs/aura.yaml --prune --output table")
+
+	helper.AssertErr("")
+	helper.AssertOut("No changes, your Aura resources match the spec")
+}
@@ -0,0 +1,89 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/report"
+)
+
+func NewInventoryCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		format      string
+		file        string
+		concurrency int
+	)
+
+	const (
+		formatFlag      = "format"
+		fileFlag        = "file"
+		concurrencyFlag = "concurrency"
+
+		defaultConcurrency = 4
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "Reports everything you run in Aura",
+		Long: `This subcommand walks all the tenants you have access to, with the full details of their instances, their customer managed keys and, when the beta is enabled, the GraphQL Data APIs of every instance.
+
+The report contains a summary, with the number of instances per type, region and cloud provider and their total memory, followed by the details of every resource. It is written in JSON, CSV or Markdown. A CSV report only contains the details, one row per resource.
+
+The report is printed, unless --file is set. In that case it is written to the file and only the summary is printed.
+
+The lists of every tenant and the GraphQL Data APIs are requested concurrently, at most --concurrency at once. The details of instances are requested 4 at once.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(report.ValidFormats, format) {
+				return fmt.Errorf(`invalid argument "%s" for "--%s" flag: must be one of "%s"`, format, formatFlag, strings.Join(report.ValidFormats, `", "`))
+			}
+			if concurrency < 1 {
+				return fmt.Errorf(`invalid argument "%d" for "--%s" flag: must be at least 1`, concurrency, concurrencyFlag)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			inventory, err := report.CollectInventory(cfg, concurrency)
+			if err != nil {
+				return err
+			}
+
+			if file == "" {
+				return inventory.Write(cmd.OutOrStdout(), format)
+			}
+
+			var buffer bytes.Buffer
+			if err := inventory.Write(&buffer, format); err != nil {
+				return err
+			}
+			if err := afero.WriteFile(cfg.Aura.Fs(), file, buffer.Bytes(), 0644); err != nil {
+				return clierr.NewFatalError("unable to write %s: %w", file, err)
+			}
+
+			summary := inventory.Summary
+			return output.PrintBodyMap(cmd, cfg, api.SingleValueResponseData{Data: map[string]any{
+				"tenants":               summary.Tenants,
+				"instances":             summary.Instances,
+				"customer_managed_keys": summary.CustomerManagedKeys,
+				"graphql_data_apis":     summary.GraphQLDataApis,
+				"total_memory":          summary.TotalMemory,
+			}}, []string{"tenants", "instances", "customer_managed_keys", "graphql_data_apis", "total_memory"})
+		},
+	}
+
+	cmd.Flags().StringVar(&format, formatFlag, report.FormatJson, fmt.Sprintf("Format of the report, from a choice of [%s]", strings.Join(report.ValidFormats, ", ")))
+	cmd.Flags().StringVar(&file, fileFlag, "", "File to write the report to")
+	cmd.Flags().IntVar(&concurrency, concurrencyFlag, defaultConcurrency, "The maximum number of requests sent at once")
+
+	return cmd
+}
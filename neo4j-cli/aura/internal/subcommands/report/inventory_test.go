@@ -0,0 +1,242 @@
+package report_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func mockInventory(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "2f49c2b3",
+				"name": "orders",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			},
+			{
+				"id": "b51dc964",
+				"name": "catalog",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{
+				"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+				"name": "production-key",
+				"tenant_id": "YOUR_TENANT_ID"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "orders",
+			"status": "running",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "8GB",
+			"storage": "16GB",
+			"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4"
+		}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {
+			"id": "b51dc964",
+			"name": "catalog",
+			"status": "paused",
+			"tenant_id": "YOUR_TENANT_ID",
+			"cloud_provider": "gcp",
+			"region": "us-central1",
+			"type": "professional-db",
+			"memory": "512MB",
+			"storage": "1GB"
+		}
+	}`)
+}
+
+func TestInventoryMarkdown(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInventory(&helper)
+
+	helper.ExecuteCommand("report inventory --format markdown")
+
+	helper.AssertOut(`# Aura inventory
+
+## Summary
+
+| Tenants | Instances | Customer managed keys | GraphQL Data APIs | Total memory |
+| --- | --- | --- | --- | --- |
+| 1 | 2 | 1 | 0 | 8.5GB |
+
+### Instances by type
+
+| Type | Instances |
+| --- | --- |
+| enterprise-db | 1 |
+| professional-db | 1 |
+
+### Instances by region
+
+| Region | Instances |
+| --- | --- |
+| europe-west1 | 1 |
+| us-central1 | 1 |
+
+### Instances by cloud provider
+
+| Cloud provider | Instances |
+| --- | --- |
+| gcp | 2 |
+
+## Tenant Production (YOUR_TENANT_ID)
+
+### Instances
+
+| ID | Name | Status | Type | Cloud provider | Region | Memory | Storage | Customer managed key |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- |
+| 2f49c2b3 | orders | running | enterprise-db | gcp | europe-west1 | 8GB | 16GB | f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4 |
+| b51dc964 | catalog | paused | professional-db | gcp | us-central1 | 512MB | 1GB |  |
+
+### Customer managed keys
+
+| ID | Name |
+| --- | --- |
+| f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4 | production-key |
+
+`)
+}
+
+func TestInventoryCsvWithGraphQLDataApis(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	mockInventory(&helper)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{
+				"id": "7bc2a5d1",
+				"name": "orders-api",
+				"status": "ready",
+				"url": "https://7bc2a5d1.graphql.neo4j.io/graphql"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964/data-apis/graphql", http.StatusOK, `{
+		"data": []
+	}`)
+
+	helper.ExecuteCommand("report inventory --format csv")
+
+	helper.AssertOut(`kind,tenant_id,tenant_name,instance_id,id,name,status,type,cloud_provider,region,memory,storage,customer_managed_key_id,url
+instance,YOUR_TENANT_ID,Production,,2f49c2b3,orders,running,enterprise-db,gcp,europe-west1,8GB,16GB,f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4,
+graphql-data-api,YOUR_TENANT_ID,Production,2f49c2b3,7bc2a5d1,orders-api,ready,,,,,,,https://7bc2a5d1.graphql.neo4j.io/graphql
+instance,YOUR_TENANT_ID,Production,,b51dc964,catalog,paused,professional-db,gcp,us-central1,512MB,1GB,,
+customer-managed-key,YOUR_TENANT_ID,Production,,f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4,production-key,,,,,,,,
+`)
+}
+
+func TestInventoryJsonToFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInventory(&helper)
+
+	helper.ExecuteCommand("report inventory --file inventory.json")
+
+	helper.AssertOutJson(`{
+		"data": {
+			"customer_managed_keys": 1,
+			"graphql_data_apis": 0,
+			"instances": 2,
+			"tenants": 1,
+			"total_memory": "8.5GB"
+		}
+	}`)
+
+	report := helper.ReadFile("inventory.json")
+	expected, err := testutils.FormatJson(`{
+		"summary": {
+			"tenants": 1,
+			"instances": 2,
+			"customer_managed_keys": 1,
+			"graphql_data_apis": 0,
+			"total_memory": "8.5GB",
+			"instances_by_type": {"enterprise-db": 1, "professional-db": 1},
+			"instances_by_region": {"europe-west1": 1, "us-central1": 1},
+			"instances_by_cloud_provider": {"gcp": 2}
+		},
+		"tenants": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production",
+				"instances": [
+					{
+						"id": "2f49c2b3",
+						"name": "orders",
+						"status": "running",
+						"type": "enterprise-db",
+						"cloud_provider": "gcp",
+						"region": "europe-west1",
+						"memory": "8GB",
+						"storage": "16GB",
+						"customer_managed_key_id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+						"graphql_data_apis": []
+					},
+					{
+						"id": "b51dc964",
+						"name": "catalog",
+						"status": "paused",
+						"type": "professional-db",
+						"cloud_provider": "gcp",
+						"region": "us-central1",
+						"memory": "512MB",
+						"storage": "1GB",
+						"customer_managed_key_id": "",
+						"graphql_data_apis": []
+					}
+				],
+				"customer_managed_keys": [
+					{
+						"id": "f15cfd42-2a32-4e94-9b5c-5b4ba1dec9a4",
+						"name": "production-key"
+					}
+				]
+			}
+		]
+	}`, "\t")
+	assert.Nil(t, err)
+	actual, err := testutils.FormatJson(report, "\t")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestInventoryWithInvalidFormat(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("report inventory --format xml")
+
+	helper.AssertErr(`Error: invalid argument "xml" for "--format" flag: must be one of "json", "csv", "markdown"
+`)
+}
@@ -0,0 +1,122 @@
+package shell
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/commandline"
+)
+
+// The commands of the shell completed next to the aura commands
+var shellCommands = []candidate{
+	{"use", "Uses a tenant or an instance for the next commands"},
+	{"history", "Prints the commands run in the shell"},
+	{"exit", "Quits the shell"},
+}
+
+type candidate struct {
+	value       string
+	description string
+}
+
+// Completes the word before the cursor on tab. The word is completed as far as all candidates agree,
+// and the candidates are listed when there is nothing more to complete.
+func (s *session) completeKey(t *term.Terminal) func(line string, pos int, key rune) (string, int, bool) {
+	return func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		before, after := line[:pos], line[pos:]
+		candidates, word := s.complete(before)
+		if len(candidates) == 0 {
+			return "", 0, false
+		}
+
+		common := candidates[0].value
+		for _, c := range candidates[1:] {
+			for !strings.HasPrefix(c.value, common) {
+				common = common[:len(common)-1]
+			}
+		}
+		if len(candidates) == 1 {
+			common += " "
+		}
+		if len(common) > len(word) {
+			completed := before[:len(before)-len(word)] + common
+			return completed + after, len(completed), true
+		}
+
+		list := &strings.Builder{}
+		for _, c := range candidates {
+			fmt.Fprintf(list, "%-24s%s\n", c.value, c.description)
+		}
+		fmt.Fprint(t, list.String())
+		return "", 0, false
+	}
+}
+
+// The candidates for the last word of a line, using the completions of the aura commands, and the word they complete
+func (s *session) complete(line string) ([]candidate, string) {
+	words, err := shlex.Split(line)
+	if err != nil {
+		return nil, ""
+	}
+	word := ""
+	if len(words) > 0 && !strings.HasSuffix(line, " ") {
+		word = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	candidates := []candidate{}
+	switch {
+	case len(words) == 1 && words[0] == "use":
+		candidates = []candidate{{"tenant", "Uses a tenant"}, {"instance", "Uses an instance"}, {"none", "Stops using the tenant and the instance"}}
+	case len(words) == 2 && words[0] == "use":
+		// The IDs are the same as those of the get commands
+		candidates = s.completions([]string{words[1], "get"}, word)
+	case len(words) > 0 && (words[0] == "use" || commandline.IsUnavailable(words[0])):
+	default:
+		if len(words) == 0 {
+			candidates = slices.Clone(shellCommands)
+		}
+		for _, c := range s.completions(s.withContext(words, false), word) {
+			if !commandline.IsUnavailable(c.value) {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+
+	return slices.DeleteFunc(candidates, func(c candidate) bool {
+		return !strings.HasPrefix(c.value, word)
+	}), word
+}
+
+// The completions of cobra for the arguments of an aura command
+func (s *session) completions(args []string, word string) []candidate {
+	out := &bytes.Buffer{}
+	root := s.newRoot()
+	root.SetArgs(append(append([]string{cobra.ShellCompRequestCmd}, args...), word))
+	root.SetOut(out)
+	root.SetErr(io.Discard)
+	if err := root.Execute(); err != nil {
+		return nil
+	}
+
+	candidates := []candidate{}
+	for _, line := range strings.Split(out.String(), "\n") {
+		// The last line is the completion directive, such as :4
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue
+		}
+		value, description, _ := strings.Cut(line, "\t")
+		candidates = append(candidates, candidate{value, description})
+	}
+	return candidates
+}
@@ -0,0 +1,191 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/commandline"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+)
+
+// Flags that select instances in place of an instance ID, such as instance pause --all
+var selectorFlags = []string{"all", "tenant-id", "name-glob", "type", "status"}
+
+type session struct {
+	cfg     *clicfg.Config
+	newRoot func() *cobra.Command
+	in      io.Reader
+	out     io.Writer
+	err     io.Writer
+	// The default tenant of the config, used again after use none
+	defaultTenant string
+	// The tenant and instance in use, nil when there is none
+	tenant   map[string]any
+	instance map[string]any
+	history  []string
+}
+
+// Runs a line of the shell, returning true when the shell should be closed
+func (s *session) run(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return false
+	}
+	s.history = append(s.history, line)
+
+	args, err := shlex.Split(line)
+	if err != nil {
+		s.fail(err)
+		return false
+	}
+	args = commandline.TrimPrefix(args)
+	if len(args) == 0 {
+		return false
+	}
+
+	switch {
+	case args[0] == "exit" || args[0] == "quit":
+		return true
+	case args[0] == "use":
+		s.use(args[1:])
+	case args[0] == "history":
+		for i, command := range s.history {
+			fmt.Fprintf(s.out, "%4d  %s\n", i+1, command)
+		}
+	case commandline.IsUnavailable(args[0]):
+		s.fail(fmt.Errorf("%s cannot be run from the shell", args[0]))
+	default:
+		s.execute(args)
+	}
+	return false
+}
+
+// Errors are printed by the command, and the shell carries on with the next line, even when the command panics
+// such as when the access token cannot be retrieved
+func (s *session) execute(args []string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.fail(fmt.Errorf("%v", r))
+		}
+	}()
+
+	args = s.withContext(args, true)
+	root := s.newRoot()
+	root.SetArgs(args)
+	root.SetIn(s.in)
+	root.SetOut(s.out)
+	root.SetErr(s.err)
+	s.cfg.Aura.SetErrWriter(s.err)
+	s.cfg.Aura.SetCommandArgs(args)
+	root.Execute()
+}
+
+func (s *session) use(args []string) {
+	switch {
+	case len(args) == 0:
+		s.printContext()
+	case len(args) == 1 && args[0] == "none":
+		s.tenant = nil
+		s.instance = nil
+		s.cfg.Aura.Override("default-tenant", s.defaultTenant)
+		s.printContext()
+	case len(args) == 2 && args[0] == "tenant":
+		tenantId, err := api.ResolveTenantId(s.cfg, args[1])
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		tenant, err := api.GetTenant(s.cfg, tenantId)
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		s.tenant = tenant
+		s.cfg.Aura.Override("default-tenant", tenantId)
+		fmt.Fprintf(s.out, "Using tenant %s\n", label(tenant))
+	case len(args) == 2 && args[0] == "instance":
+		instanceId, err := api.ResolveInstanceId(s.cfg, args[1])
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		instance, err := api.GetInstance(s.cfg, instanceId)
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		s.instance = instance
+		fmt.Fprintf(s.out, "Using instance %s\n", label(instance))
+	default:
+		s.fail(fmt.Errorf("use must be followed by tenant <id>, instance <id> or none"))
+	}
+}
+
+func (s *session) printContext() {
+	if s.tenant == nil && s.instance == nil {
+		fmt.Fprintln(s.out, "No tenant or instance in use")
+	}
+	if s.tenant != nil {
+		fmt.Fprintf(s.out, "Using tenant %s\n", label(s.tenant))
+	}
+	if s.instance != nil {
+		fmt.Fprintf(s.out, "Using instance %s\n", label(s.instance))
+	}
+}
+
+// Adds the instance in use to a command that takes an instance ID, unless the command is given one.
+// With withArg false, the instance is only added as --instance-id, such as when the argument is being completed.
+func (s *session) withContext(args []string, withArg bool) []string {
+	if s.instance == nil {
+		return args
+	}
+	cmd, rest, err := s.newRoot().Find(args)
+	// Invalid commands and flags are reported when the command is run
+	if err != nil || cmd.ParseFlags(rest) != nil {
+		return args
+	}
+	instanceId := fmt.Sprint(s.instance["id"])
+	if flag := cmd.Flags().Lookup("instance-id"); flag != nil {
+		if flag.Changed {
+			return args
+		}
+		return append(args, "--instance-id", instanceId)
+	}
+	if !withArg || cmd.Annotations[completion.ArgAnnotation] != string(completion.Instance) || cmd.Flags().NArg() > 0 {
+		return args
+	}
+	for _, name := range selectorFlags {
+		if flag := cmd.Flags().Lookup(name); flag != nil && flag.Changed {
+			return args
+		}
+	}
+	return append(args, instanceId)
+}
+
+// The prompt names the tenant and the instance in use, such as aura (Production/Northwind)>
+func (s *session) prompt() string {
+	names := []string{}
+	for _, value := range []map[string]any{s.tenant, s.instance} {
+		if value != nil {
+			names = append(names, fmt.Sprint(value["name"]))
+		}
+	}
+	if len(names) == 0 {
+		return "aura> "
+	}
+	return fmt.Sprintf("aura (%s)> ", strings.Join(names, "/"))
+}
+
+func (s *session) fail(err error) {
+	fmt.Fprintf(s.err, "Error: %s\n", err)
+}
+
+func label(value map[string]any) string {
+	return fmt.Sprintf("%v (%v)", value["name"], value["id"])
+}
@@ -0,0 +1,108 @@
+package shell
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+)
+
+// newRoot creates the aura command for every line of the shell, so that the flags of a line are not kept for the next one
+func NewCmd(cfg *clicfg.Config, newRoot func() *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:   "shell",
+		Short: "Starts an interactive shell for aura commands",
+		Long: `Starts an interactive shell that runs aura commands, such as instance list, without the neo4j-cli aura prefix. The config and the credentials are read once, and every command of the shell shares the same access token and connections.
+
+Besides the aura commands, the shell has the following commands:
+	use tenant <id>		Uses the tenant as the default tenant of the commands, as with config set default-tenant
+	use instance <id>	Uses the instance for the commands that take an instance ID, such as instance get or instance snapshot list, when no instance ID is given
+	use none		Stops using the tenant and the instance
+	use			Prints the tenant and the instance in use
+	history			Prints the commands run in the shell
+	exit			Quits the shell, as do Ctrl+D and Ctrl+C
+
+In a terminal, the up and down arrow keys recall the previous commands, and the tab key completes commands, flags, and the IDs of resources and the values of flags.
+
+When the input is not a terminal, such as a pipe, a command is read from every line of the input.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			s := &session{
+				cfg:           cfg,
+				newRoot:       newRoot,
+				in:            cmd.InOrStdin(),
+				out:           cmd.OutOrStdout(),
+				err:           cmd.ErrOrStderr(),
+				defaultTenant: cfg.Aura.DefaultTenant(),
+			}
+			if output.IsTerminal(s.in) {
+				return s.interactive(s.in.(*os.File))
+			}
+			return s.script()
+		},
+	}
+}
+
+// Reads commands with line editing, history and completion
+func (s *session) interactive(in *os.File) error {
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{in, s.out}, s.prompt())
+	t.AutoCompleteCallback = s.completeKey(t)
+
+	fd := int(in.Fd())
+	for {
+		if out, ok := s.out.(*os.File); ok {
+			if width, height, err := term.GetSize(int(out.Fd())); err == nil && width > 0 {
+				t.SetSize(width, height)
+			}
+		}
+
+		// The terminal is only raw while reading, so that commands read their input and are interrupted as usual
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		line, err := t.ReadLine()
+		term.Restore(fd, state)
+		if err == io.EOF {
+			fmt.Fprintln(s.out)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.run(line) {
+			return nil
+		}
+		t.SetPrompt(s.prompt())
+	}
+}
+
+// Reads a command from every line of the input
+func (s *session) script() error {
+	// Commands that ask for confirmation read their answer from the same buffered input
+	reader := bufio.NewReader(s.in)
+	s.in = reader
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" && s.run(line) {
+			return nil
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package shell_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestShell(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`).AddResponse(http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	snapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/b51dc964/snapshots", http.StatusOK, `{
+		"data": [
+			{"snapshot_id": "afdb4e9d", "instance_id": "b51dc964", "profile": "AdHoc", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"}
+		]
+	}`)
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.SetInput(`use
+use instance b51dc964
+instance get -q
+
+# Commands can keep the prefix of the command line
+neo4j-cli aura instance snapshot list -q
+history
+exit
+instance list
+`)
+
+	helper.ExecuteCommand("shell")
+
+	getMock.AssertCalledTimes(2)
+	snapshotsMock.AssertCalledTimes(1)
+	listMock.AssertCalledTimes(0)
+	helper.AssertErr("")
+	helper.AssertOut(`No tenant or instance in use
+Using instance Northwind (b51dc964)
+b51dc964
+afdb4e9d
+   1  use
+   2  use instance b51dc964
+   3  instance get -q
+   4  neo4j-cli aura instance snapshot list -q
+   5  history`)
+}
+
+func TestShellUsesInstanceUnlessIdIsGiven(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+		"data": {"id": "2f49c2b3", "name": "Recommendations", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	snapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": []}`)
+	helper.SetInput(`use instance b51dc964
+instance get 2f49c2b3 -q
+instance snapshot list --instance-id 2f49c2b3 -q
+`)
+
+	helper.ExecuteCommand("shell")
+
+	getMock.AssertCalledTimes(1)
+	snapshotsMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Using instance Northwind (b51dc964)
+2f49c2b3`)
+}
+
+func TestShellUsesTenantAsDefaultTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.default-tenant", "YOUR_TENANT_ID")
+	helper.NewRequestHandlerMock("GET /v1/tenants/da045ab3-3b89-4f45-8b96-528f2e47cd13", http.StatusOK, `{
+		"data": {"id": "da045ab3-3b89-4f45-8b96-528f2e47cd13", "name": "Development", "instance_configurations": []}
+	}`)
+	helper.SetInput(`use tenant da045ab3-3b89-4f45-8b96-528f2e47cd13
+config get default-tenant
+use none
+config get default-tenant
+`)
+
+	helper.ExecuteCommand("shell")
+
+	helper.AssertErr("")
+	helper.AssertOut(`Using tenant Development (da045ab3-3b89-4f45-8b96-528f2e47cd13)
+da045ab3-3b89-4f45-8b96-528f2e47cd13
+No tenant or instance in use
+YOUR_TENANT_ID`)
+}
+
+func TestShellCarriesOnAfterErrors(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetInput(`use instance
+instance get "b51dc964
+dashboard
+batch
+use
+`)
+
+	helper.ExecuteCommand("shell")
+
+	helper.AssertErr(`Error: use must be followed by tenant <id>, instance <id> or none
+Error: EOF found when expecting closing quote
+Error: dashboard cannot be run from the shell
+Error: batch cannot be run from the shell`)
+	helper.AssertOut("No tenant or instance in use")
+}
+
+func TestShellCarriesOnAfterPanics(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, `{
+		"data": {"id": "YOUR_TENANT_ID", "name": "Production", "instance_configurations": []}
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID/metrics-integration", http.StatusAccepted, `{"data": {}}`)
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [{"id": "2f49c2b3", "name": "Recommendations", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}]
+	}`)
+	helper.SetInput(`tenant get YOUR_TENANT_ID
+instance list -q
+`)
+
+	helper.ExecuteCommand("shell")
+
+	listMock.AssertCalledTimes(1)
+	helper.AssertErr("Error: unexpected statusCode 202")
+	helper.AssertOut("2f49c2b3")
+}
+
+func TestShellRecordsCommandInAuditLog(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/b51dc964", http.StatusOK, `{
+		"data": {"id": "b51dc964", "name": "Northwind", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+	}`)
+	helper.NewRequestHandlerMock("POST /v1/instances/b51dc964/pause", http.StatusAccepted, `{
+		"data": {"id": "b51dc964", "status": "pausing"}
+	}`)
+	helper.SetInput(`use instance b51dc964
+instance pause -q
+`)
+
+	helper.ExecuteCommand("shell")
+
+	log := helper.ReadFile(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "audit.jsonl"))
+	assert.Equal(t, "instance pause -q b51dc964", gjson.Get(log, "command").String())
+	assert.Equal(t, "/instances/b51dc964/pause", gjson.Get(log, "path").String())
+}
@@ -1,28 +1,54 @@
 package tenant
 
 import (
+	"cmp"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	var (
+		_type         flags.InstanceType
+		cloudProvider flags.CloudProvider
+		region        string
+		version       string
+	)
+
+	const (
+		typeFlag          = "type"
+		cloudProviderFlag = "cloud-provider"
+		regionFlag        = "region"
+		versionFlag       = "version"
+	)
+
+	cmd := &cobra.Command{
 		Use:   "get <id>",
 		Short: "Returns tenant details",
-		Long:  "This subcommand returns details about a specific Aura Tenant.",
-		Args:  cobra.ExactArgs(1),
+		Long: `This subcommand returns details about a specific Aura Tenant.
+
+With table output, the instance configurations of the tenant are printed as a second table sorted by cloud provider, region, type and memory, to show what can be provisioned in the tenant.
+
+The instance configurations can be filtered with --type, --cloud-provider, --region and --version, with any output.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			tenantId := args[0]
+			cmd.SilenceUsage = true
+			tenantId, err := api.ResolveTenantId(cfg, args[0])
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/tenants/%s", tenantId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -31,20 +57,89 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				responseData := api.ParseBody(resBody)
+				tenant, err := api.ParseBody(resBody).GetSingleOrError()
+				if err != nil {
+					return err
+				}
+				configurations := filterInstanceConfigurations(tenant, map[string]string{"type": _type.String(), "cloud_provider": cloudProvider.String(), "region": region, "version": version})
+				if cmd.Flags().Changed(typeFlag) || cmd.Flags().Changed(cloudProviderFlag) || region != "" || version != "" {
+					tenant["instance_configurations"] = configurations
+				}
+				responseData := api.NewSingleValueResponseData(tenant)
+
 				fields, values, err := postProcessResponseValues(cfg, tenantId, responseData)
 				if err != nil {
 					return err
 				}
-				output.PrintBodyMap(cmd, cfg, values, fields)
-				if cfg.Aura.Output() == "table" || cfg.Aura.Output() == "default" {
-					cmd.Println("instance configurations are not visible with table output - please use a different output setting using --output if you would like to view these")
+				if err := output.PrintBodyMap(cmd, cfg, values, fields); err != nil {
+					return err
+				}
+				// Other row based formats such as csv are parsed, so cannot be followed by a table with other columns
+				if output.IsTable(cfg) {
+					return printInstanceConfigurations(cmd, cfg, configurations)
 				}
 			}
 
 			return nil
 		},
 	}
+
+	cmd.Flags().Var(&_type, typeFlag, "Only shows the instance configurations of this instance type")
+	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "Only shows the instance configurations of this cloud provider")
+	cmd.Flags().StringVar(&region, regionFlag, "", "Only shows the instance configurations in this region")
+	cmd.Flags().StringVar(&version, versionFlag, "", "Only shows the instance configurations of this Neo4j version")
+
+	output.AddWatchFlags(cmd)
+
+	completion.Arg(cmd, cfg, completion.Tenant)
+
+	return cmd
+}
+
+// The instance configurations of the tenant matching every non-empty filter
+func filterInstanceConfigurations(tenant map[string]any, filters map[string]string) []map[string]any {
+	configurations := []map[string]any{}
+	values, _ := tenant["instance_configurations"].([]any)
+	for _, value := range values {
+		configuration, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		matches := true
+		for key, filter := range filters {
+			if configurationValue, _ := configuration[key].(string); filter != "" && !strings.EqualFold(configurationValue, filter) {
+				matches = false
+			}
+		}
+		if matches {
+			configurations = append(configurations, configuration)
+		}
+	}
+	return configurations
+}
+
+// Prints the instance configurations sorted by cloud provider, region, type and memory
+func printInstanceConfigurations(cmd *cobra.Command, cfg *clicfg.Config, configurations []map[string]any) error {
+	if len(configurations) == 0 {
+		cmd.Println("No instance configurations")
+		return nil
+	}
+	slices.SortStableFunc(configurations, func(a map[string]any, b map[string]any) int {
+		for _, key := range []string{"cloud_provider", "region", "type"} {
+			valueA, _ := a[key].(string)
+			valueB, _ := b[key].(string)
+			if c := strings.Compare(valueA, valueB); c != 0 {
+				return c
+			}
+		}
+		memoryA, _ := a["memory"].(string)
+		memoryB, _ := b["memory"].(string)
+		sizeA, _ := api.MemoryInGB(memoryA)
+		sizeB, _ := api.MemoryInGB(memoryB)
+		return cmp.Compare(sizeA, sizeB)
+	})
+	cmd.Println("Instance configurations")
+	return output.PrintSecondaryBodyMap(cmd, cfg, api.NewResponseData(configurations), []string{"cloud_provider", "region", "region_name", "type", "memory", "storage", "version"})
 }
 
 func postProcessResponseValues(cfg *clicfg.Config, tenantId string, responseData api.ResponseData) ([]string, api.ResponseData, error) {
@@ -145,6 +145,267 @@ func TestGetTenantWithTableOutput(t *testing.T) {
 ├──────────────────────────────────────┼────────────┤
 │ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │
 └──────────────────────────────────────┴────────────┘
-instance configurations are not visible with table output - please use a different output setting using --output if you would like to view these
+No instance configurations
+`)
+}
+
+func TestGetTenantByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	listMockHandler := helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+			"data": [
+				{
+					"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+					"name": "Production"
+				},
+				{
+					"id": "bf2b4e1c-6a3e-4d2c-a15e-5b0d6e0d5e2f",
+					"name": "Staging"
+				}
+			]
+		}`)
+	getMockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, `{
+			"data": {
+				"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+				"name": "Production",
+				"instance_configurations": []
+			}
+		}`)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(`tenant get "name:Production"`)
+
+	listMockHandler.AssertCalledTimes(1)
+	getMockHandler.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"instance_configurations": [],
+			"name": "Production"
+		}
+	}
+	`)
+}
+
+const tenantWithInstanceConfigurations = `{
+	"data": {
+		"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+		"name": "Production",
+		"instance_configurations": [
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "professional-db", "memory": "16GB", "storage": "32GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"},
+			{"cloud_provider": "aws", "region": "us-east-1", "region_name": "N. Virginia", "type": "enterprise-db", "memory": "8GB", "storage": "16GB", "version": "5"},
+			{"cloud_provider": "gcp", "region": "europe-west1", "region_name": "Belgium", "type": "enterprise-db", "memory": "8GB", "storage": "16GB", "version": "4"},
+			{"cloud_provider": "aws", "region": "eu-west-1", "region_name": "Ireland", "type": "professional-db", "memory": "4GB", "storage": "8GB", "version": "5"}
+		]
+	}
+}`
+
+func TestGetTenantInstanceConfigurationsWithTableOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output table", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌──────────────────────────────────────┬────────────┐
+│ ID                                   │ NAME       │
+├──────────────────────────────────────┼────────────┤
+│ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │
+└──────────────────────────────────────┴────────────┘
+Instance configurations
+┌────────────────┬──────────────┬─────────────┬─────────────────┬────────┬─────────┬─────────┐
+│ CLOUD_PROVIDER │ REGION       │ REGION_NAME │ TYPE            │ MEMORY │ STORAGE │ VERSION │
+├────────────────┼──────────────┼─────────────┼─────────────────┼────────┼─────────┼─────────┤
+│ aws            │ eu-west-1    │ Ireland     │ professional-db │ 4GB    │ 8GB     │ 5       │
+│ aws            │ us-east-1    │ N. Virginia │ enterprise-db   │ 8GB    │ 16GB    │ 5       │
+│ gcp            │ europe-west1 │ Belgium     │ enterprise-db   │ 8GB    │ 16GB    │ 4       │
+│ gcp            │ europe-west1 │ Belgium     │ professional-db │ 4GB    │ 8GB     │ 5       │
+│ gcp            │ europe-west1 │ Belgium     │ professional-db │ 16GB   │ 32GB    │ 5       │
+└────────────────┴──────────────┴─────────────┴─────────────────┴────────┴─────────┴─────────┘
+`)
+}
+
+func TestGetTenantWithColumnsOnlySelectsColumnsOfTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output table --columns name --cloud-provider aws", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌────────────┐
+│ NAME       │
+├────────────┤
+│ Production │
+└────────────┘
+Instance configurations
+┌────────────────┬───────────┬─────────────┬─────────────────┬────────┬─────────┬─────────┐
+│ CLOUD_PROVIDER │ REGION    │ REGION_NAME │ TYPE            │ MEMORY │ STORAGE │ VERSION │
+├────────────────┼───────────┼─────────────┼─────────────────┼────────┼─────────┼─────────┤
+│ aws            │ eu-west-1 │ Ireland     │ professional-db │ 4GB    │ 8GB     │ 5       │
+│ aws            │ us-east-1 │ N. Virginia │ enterprise-db   │ 8GB    │ 16GB    │ 5       │
+└────────────────┴───────────┴─────────────┴─────────────────┴────────┴─────────┴─────────┘
+`)
+}
+
+func TestGetTenantWithCsvOutputPrintsOneTable(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output csv", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`id,name
+6981ace7-efe8-4f5c-b7c5-267b5162ce91,Production`)
+}
+
+func TestGetTenantInstanceConfigurationsWithFilters(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output table --cloud-provider gcp --type professional-db --region EUROPE-WEST1", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌──────────────────────────────────────┬────────────┐
+│ ID                                   │ NAME       │
+├──────────────────────────────────────┼────────────┤
+│ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │
+└──────────────────────────────────────┴────────────┘
+Instance configurations
+┌────────────────┬──────────────┬─────────────┬─────────────────┬────────┬─────────┬─────────┐
+│ CLOUD_PROVIDER │ REGION       │ REGION_NAME │ TYPE            │ MEMORY │ STORAGE │ VERSION │
+├────────────────┼──────────────┼─────────────┼─────────────────┼────────┼─────────┼─────────┤
+│ gcp            │ europe-west1 │ Belgium     │ professional-db │ 4GB    │ 8GB     │ 5       │
+│ gcp            │ europe-west1 │ Belgium     │ professional-db │ 16GB   │ 32GB    │ 5       │
+└────────────────┴──────────────┴─────────────┴─────────────────┴────────┴─────────┴─────────┘
+`)
+}
+
+func TestGetTenantInstanceConfigurationsWithFiltersAndJsonOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --version 4", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+			"instance_configurations": [
+				{
+					"cloud_provider": "gcp",
+					"memory": "8GB",
+					"region": "europe-west1",
+					"region_name": "Belgium",
+					"storage": "16GB",
+					"type": "enterprise-db",
+					"version": "4"
+				}
+			],
+			"name": "Production"
+		}
+	}`)
+}
+
+func TestGetTenantInstanceConfigurationsWithoutMatches(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, tenantWithInstanceConfigurations)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output table --type enterprise-ds", tenantId))
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌──────────────────────────────────────┬────────────┐
+│ ID                                   │ NAME       │
+├──────────────────────────────────────┼────────────┤
+│ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │
+└──────────────────────────────────────┴────────────┘
+No instance configurations
 `)
 }
@@ -10,12 +10,31 @@ import (
 )
 
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	var (
+		allCredentials bool
+		listOptions    output.ListOptions
+	)
+
+	const allCredentialsFlag = "all-credentials"
+
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of tenants",
-		Long:  "This subcommand returns a list containing a summary of each of your Aura Tenants. To find out more about a specific Tenant, retrieve the details using the get subcommand.",
+		Long: `This subcommand returns a list containing a summary of each of your Aura Tenants. To find out more about a specific Tenant, retrieve the details using the get subcommand.
+
+With --all-credentials, the tenants are listed with every stored credential rather than only the default one, and a credential column shows the credential each tenant was listed with.
+
+The listed tenants can be filtered with --filter, such as --filter 'name~*production*', and sorted with --sort, such as --sort name.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			if allCredentials {
+				tenants, err := api.ListWithAllCredentials(cfg, "/tenants", nil)
+				if err != nil {
+					return err
+				}
+				return output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.NewResponseData(tenants)), []string{api.CredentialField, "id", "name"})
+			}
+
 			resBody, statusCode, err := api.MakeRequest(cfg, "/tenants", &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -24,10 +43,20 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name"})
+				if err := output.PrintBodyMap(cmd, cfg, listOptions.Apply(api.ParseBody(resBody)), []string{"id", "name"}); err != nil {
+					return err
+				}
 			}
 
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&allCredentials, allCredentialsFlag, false, "Lists the tenants of every stored credential")
+
+	output.AddListFlags(cmd, &listOptions)
+
+	output.AddWatchFlags(cmd)
+
+	return cmd
 }
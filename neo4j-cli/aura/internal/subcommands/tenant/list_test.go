@@ -60,3 +60,105 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
+
+func TestListTenantsWithAllCredentials(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]any{
+		{"name": "analytics", "access-token": "dsa", "token-expiry": 123},
+		{"name": "retail", "access-token": "dsa", "token-expiry": 123},
+	})
+
+	body := `{
+		"data": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`
+	mockHandler := helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, body).AddResponse(http.StatusOK, body)
+
+	helper.ExecuteCommand("tenant list --all-credentials")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"credential": "analytics",
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			},
+			{
+				"credential": "retail",
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`)
+}
+
+func TestListTenantsWithAllCredentialsError(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]any{
+		{"name": "analytics", "access-token": "dsa", "token-expiry": 123},
+	})
+
+	helper.NewRequestHandlerMock("/v1/tenants", http.StatusInternalServerError, `{
+		"errors": [
+			{
+				"message": "Internal server error",
+				"reason": "internal-server-error"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("tenant list --all-credentials")
+
+	helper.AssertErr("Error: listing with credential analytics: [Internal server error]\n")
+}
+
+func TestListTenantsWithAllCredentialsAndFilterAndSort(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]any{
+		{"name": "analytics", "access-token": "dsa", "token-expiry": 123},
+		{"name": "retail", "access-token": "dsa", "token-expiry": 123},
+	})
+
+	body := `{
+		"data": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			},
+			{
+				"id": "da045ab3",
+				"name": "Development"
+			}
+		]
+	}`
+	helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, body).AddResponse(http.StatusOK, body)
+
+	helper.ExecuteCommand("tenant list --all-credentials --filter 'name!=development' --sort -credential")
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"credential": "retail",
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			},
+			{
+				"credential": "analytics",
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`)
+}
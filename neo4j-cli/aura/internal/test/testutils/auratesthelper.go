@@ -24,10 +24,12 @@ import (
 type AuraTestHelper struct {
 	mux         *http.ServeMux
 	Server      *httptest.Server
+	in          *bytes.Buffer
 	out         *bytes.Buffer
 	err         *bytes.Buffer
 	cfg         string
 	credentials string
+	files       map[string]string
 	fs          afero.Fs
 	t           *testing.T
 }
@@ -43,16 +45,23 @@ func (helper *AuraTestHelper) ExecuteCommand(command string) {
 	fs, err := testfs.GetTestFs(helper.cfg, helper.credentials)
 	assert.Nil(helper.t, err)
 
+	for path, content := range helper.files {
+		assert.Nil(helper.t, afero.WriteFile(fs, path, []byte(content), 0600))
+	}
+
 	helper.fs = fs
 
 	cfg := clicfg.NewConfig(fs, "test")
 
 	cfg.Aura.SetPollingConfig(5, 0)
+	cfg.Aura.SetErrWriter(helper.err)
+	cfg.Aura.SetCommandArgs(args)
 
 	cmd := aura.NewCmd(cfg)
 
 	cmd.SetArgs(args)
 
+	cmd.SetIn(helper.in)
 	cmd.SetOut(helper.out)
 	cmd.SetErr(helper.err)
 
@@ -73,6 +82,24 @@ func (helper *AuraTestHelper) SetConfigValue(key string, value interface{}) {
 	helper.cfg = cfg
 }
 
+// Sets the input read by the executed command
+func (helper *AuraTestHelper) SetInput(input string) {
+	helper.in = bytes.NewBufferString(input)
+}
+
+// Sets a file to be present on the file system of each executed command
+func (helper *AuraTestHelper) SetFile(path string, content string) {
+	helper.files[path] = content
+}
+
+// Reads a file from the file system of the last executed command
+func (helper *AuraTestHelper) ReadFile(path string) string {
+	data, err := afero.ReadFile(helper.fs, path)
+	assert.Nil(helper.t, err)
+
+	return string(data)
+}
+
 func (helper *AuraTestHelper) SetCredentialsValue(key string, value interface{}) {
 	credentials, err := sjson.Set(helper.credentials, key, value)
 	assert.Nil(helper.t, err)
@@ -200,14 +227,23 @@ func (helper *AuraTestHelper) NewRequestHandlerMock(path string, status int, bod
 			assert.Nil(helper.t, err)
 		}
 
+		mock.mutex.Lock()
 		requestCount := len(mock.Calls)
 		mock.Calls = append(mock.Calls, call{Method: req.Method, Path: req.URL.Path, Body: unmarshalledBody, QueryParams: req.URL.Query()})
+		mock.mutex.Unlock()
 
 		if requestCount >= len(mock.Responses) {
 			res.WriteHeader(404)
 		} else {
 			response := mock.Responses[requestCount]
 
+			if response.noResponse {
+				conn, _, err := res.(http.Hijacker).Hijack()
+				assert.Nil(helper.t, err)
+				conn.Close()
+				return
+			}
+
 			res.WriteHeader(response.status)
 			res.Write([]byte(response.body))
 		}
@@ -216,11 +252,21 @@ func (helper *AuraTestHelper) NewRequestHandlerMock(path string, status int, bod
 	return &mock
 }
 
+// Creates a mock that closes the connection of its first request without a response, further responses can be added
+func (helper *AuraTestHelper) NewNoResponseRequestHandlerMock(path string) *requestHandlerMock {
+	mock := helper.NewRequestHandlerMock(path, 0, "")
+	mock.Responses[0] = response{noResponse: true}
+
+	return mock
+}
+
 func NewAuraTestHelper(t *testing.T) AuraTestHelper {
 	helper := AuraTestHelper{}
 
 	helper.t = t
+	helper.files = map[string]string{}
 
+	helper.in = bytes.NewBufferString("")
 	helper.out = bytes.NewBufferString("")
 	helper.err = bytes.NewBufferString("")
 
@@ -3,6 +3,7 @@ package testutils
 import (
 	"fmt"
 	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -19,12 +20,16 @@ type call struct {
 type response struct {
 	body   string
 	status int
+	// Closes the connection without writing a response, as if the request timed out
+	noResponse bool
 }
 
 type requestHandlerMock struct {
 	Calls     []call
 	Responses []response
 	t         *testing.T
+	// Guards Calls against concurrent requests
+	mutex sync.Mutex
 }
 
 func (mock *requestHandlerMock) AddResponse(status int, body string) *requestHandlerMock {
@@ -36,6 +41,12 @@ func (mock *requestHandlerMock) AddResponse(status int, body string) *requestHan
 	return mock
 }
 
+func (mock *requestHandlerMock) AddNoResponse() *requestHandlerMock {
+	mock.Responses = append(mock.Responses, response{noResponse: true})
+
+	return mock
+}
+
 func (mock *requestHandlerMock) AssertCalledTimes(times int) {
 	calls := len(mock.Calls)
 